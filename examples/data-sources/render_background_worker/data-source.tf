@@ -0,0 +1,3 @@
+data "render_background_worker" "example" {
+  id = "srv-abcdefghijklmnopqest"
+}
@@ -0,0 +1,5 @@
+data "render_background_workers" "example" {}
+
+data "render_background_workers" "example" {
+  name = "render-background-worker"
+}
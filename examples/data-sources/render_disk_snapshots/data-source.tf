@@ -0,0 +1,3 @@
+data "render_disk_snapshots" "example" {
+  disk_id = render_disk.example.id
+}
@@ -0,0 +1,3 @@
+data "render_private_service" "example" {
+  id = "srv-abcdefghijklmnopqest"
+}
\ No newline at end of file
@@ -0,0 +1,5 @@
+data "render_private_services" "example" {}
+
+data "render_private_services" "example" {
+  name = "render-private-service"
+}
@@ -1,3 +1,23 @@
 provider "render" {
   api_key = "rnd_abcdefghijklmnopqestuvwxyzAB"
 }
+
+# Send requests through an egress proxy and allow slower requests
+provider "render" {
+  api_key         = "rnd_abcdefghijklmnopqestuvwxyzAB"
+  api_url         = "https://render-proxy.example.com/v1"
+  request_timeout = "30s"
+}
+
+# Retry throttled and failed requests more patiently
+provider "render" {
+  api_key        = "rnd_abcdefghijklmnopqestuvwxyzAB"
+  max_retries    = 5
+  max_retry_wait = "1m"
+}
+
+# Stay under the account rate limit when applying with high parallelism
+provider "render" {
+  api_key                 = "rnd_abcdefghijklmnopqestuvwxyzAB"
+  max_requests_per_minute = 100
+}
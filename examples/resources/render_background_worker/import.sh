@@ -0,0 +1,8 @@
+# BackgroundWorker can be imported by specifying the id.
+terraform import render_background_worker.example srv-cabcdefghijklmnopqest
+
+# or the owner ID and name, in the form <owner_id>/<name>.
+terraform import render_background_worker.example tea-cabcdefghijklmnopqest/example-background-worker
+
+# or the slug.
+terraform import render_background_worker.example example-background-worker
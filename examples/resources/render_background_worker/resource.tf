@@ -0,0 +1,44 @@
+# Minimal example of a Render background worker
+data "render_owner" "example" {
+  id = "usr-abcdefghijklmnopqest"
+}
+
+resource "render_background_worker" "example" {
+  owner_id = data.render_owner.example.id
+  name     = "render-background-worker"
+  repo     = "https://github.com/render-examples/celery"
+  service_details = {
+    env           = "python"
+    num_instances = 1
+    native_environment_details = {
+      build_command = "pip install -r requirements.txt"
+      start_command = "celery --app tasks worker --loglevel info"
+    }
+  }
+}
+
+# Full example of a Render background worker built from a Dockerfile
+resource "render_background_worker" "example" {
+  owner_id = data.render_owner.example.id
+  name     = "render-background-worker"
+  repo     = "https://github.com/render-examples/celery"
+  branch   = "main"
+  service_details = {
+    env           = "docker"
+    num_instances = 2
+    region        = "frankfurt"
+    plan          = "standard"
+    docker_details = {
+      dockerfile_path = "./Dockerfile"
+      docker_context  = "."
+    }
+    disk = {
+      name       = "worker-data"
+      mount_path = "/var/data"
+      size_gb    = 10
+    }
+  }
+  environment_variables = {
+    QUEUE = { value = "default" }
+  }
+}
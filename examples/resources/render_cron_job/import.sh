@@ -0,0 +1,8 @@
+# CronJob can be imported by specifying the id.
+terraform import render_cron_job.example crn-cabcdefghijklmnopqest
+
+# or the owner ID and name, in the form <owner_id>/<name>.
+terraform import render_cron_job.example tea-cabcdefghijklmnopqest/example-cron-job
+
+# or the slug.
+terraform import render_cron_job.example example-cron-job
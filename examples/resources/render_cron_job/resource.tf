@@ -0,0 +1,40 @@
+# Minimal example of a Render cron job
+data "render_owner" "example" {
+  id = "usr-abcdefghijklmnopqest"
+}
+
+resource "render_cron_job" "example" {
+  owner_id = data.render_owner.example.id
+  name     = "render-cron-job"
+  repo     = "https://github.com/render-examples/cron-job"
+  service_details = {
+    env      = "node"
+    schedule = "0 * * * *"
+    native_environment_details = {
+      build_command = "npm install"
+      start_command = "node cleanup.js"
+    }
+  }
+}
+
+# Full example of a Render cron job built from a Dockerfile
+resource "render_cron_job" "example" {
+  owner_id = data.render_owner.example.id
+  name     = "render-cron-job"
+  repo     = "https://github.com/render-examples/cron-job"
+  branch   = "main"
+  service_details = {
+    env      = "docker"
+    schedule = "*/15 9-17 * * 1-5"
+    region   = "frankfurt"
+    plan     = "starter"
+    docker_details = {
+      dockerfile_path = "./Dockerfile"
+      docker_context  = "."
+      docker_command  = "./bin/report --daily"
+    }
+  }
+  environment_variables = {
+    REPORT_BUCKET = { value = "reports" }
+  }
+}
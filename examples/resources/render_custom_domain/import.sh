@@ -0,0 +1,2 @@
+# Custom domain can be imported by specifying the service id and the custom domain id.
+terraform import render_custom_domain.example srv-cabcdefghijklmnopqest/cdm-cabcdefghijklmnopqest
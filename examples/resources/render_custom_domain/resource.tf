@@ -0,0 +1,30 @@
+# Minimal example of a Render custom domain
+resource "render_custom_domain" "example" {
+  service_id = render_web_service.example.id
+  name       = "app.example.com"
+}
+
+# Feed the records Render expects into your DNS provider
+resource "cloudflare_record" "example" {
+  zone_id = var.cloudflare_zone_id
+  name    = render_custom_domain.example.dns_records[0].name
+  type    = render_custom_domain.example.dns_records[0].type
+  value   = render_custom_domain.example.dns_records[0].value
+}
+
+# Create the record first and wait for Render to verify the domain
+resource "cloudflare_record" "verified" {
+  zone_id = var.cloudflare_zone_id
+  name    = "api.example.com"
+  type    = "CNAME"
+  value   = trimprefix(render_web_service.example.service_details.url, "https://")
+}
+
+resource "render_custom_domain" "verified" {
+  service_id            = render_web_service.example.id
+  name                  = cloudflare_record.verified.name
+  wait_for_verification = true
+  timeouts = {
+    create = "15m"
+  }
+}
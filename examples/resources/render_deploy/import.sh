@@ -0,0 +1,2 @@
+# Deploy can be imported by specifying the service id and the deploy id.
+terraform import render_deploy.example srv-cabcdefghijklmnopqest/dep-cabcdefghijklmnopqest
@@ -0,0 +1,30 @@
+# Deploy a web service whenever CI publishes a new image
+variable "image_digest" {
+  type = string
+}
+
+resource "render_deploy" "example" {
+  service_id = render_web_service.example.id
+  image_url  = "docker.io/example/app@${var.image_digest}"
+  triggers = {
+    image_digest = var.image_digest
+  }
+  wait_for_completion = true
+  timeouts = {
+    create = "20m"
+  }
+}
+
+# Redeploy a git-backed service with a clean build cache
+variable "git_sha" {
+  type = string
+}
+
+resource "render_deploy" "rebuild" {
+  service_id  = render_web_service.example.id
+  commit_id   = var.git_sha
+  clear_cache = true
+  triggers = {
+    git_sha = var.git_sha
+  }
+}
@@ -0,0 +1,2 @@
+# Disk can be imported by specifying the id.
+terraform import render_disk.example dsk-cabcdefghijklmnopqest
@@ -0,0 +1,7 @@
+# Attach a persistent disk to a service
+resource "render_disk" "example" {
+  service_id = render_web_service.example.id
+  name       = "data"
+  mount_path = "/var/data"
+  size_gb    = 10
+}
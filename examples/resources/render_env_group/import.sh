@@ -0,0 +1,2 @@
+# Environment group can be imported by specifying the id.
+terraform import render_env_group.example evg-cabcdefghijklmnopqest
@@ -0,0 +1,26 @@
+# Minimal example of a Render environment group
+data "render_owner" "example" {
+  id = "usr-abcdefghijklmnopqest"
+}
+
+resource "render_env_group" "example" {
+  owner_id = data.render_owner.example.id
+  name     = "shared-settings"
+}
+
+# Full example of a Render environment group
+resource "render_env_group" "example" {
+  owner_id = data.render_owner.example.id
+  name     = "shared-settings"
+  environment_variables = {
+    LOG_LEVEL      = { value = "info" }
+    SESSION_SECRET = { generate_value = true }
+    API_TOKEN      = { ignore_value = true }
+  }
+  secret_files = [
+    {
+      name    = "credentials.json"
+      content = file("credentials.json")
+    }
+  ]
+}
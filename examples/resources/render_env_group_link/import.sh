@@ -0,0 +1,2 @@
+# Environment group link can be imported by specifying the environment group id and the service id.
+terraform import render_env_group_link.example evg-cabcdefghijklmnopqest/srv-cabcdefghijklmnopqest
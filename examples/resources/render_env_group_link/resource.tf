@@ -0,0 +1,10 @@
+# Share one environment group with several services
+resource "render_env_group_link" "web" {
+  env_group_id = render_env_group.example.id
+  service_id   = render_web_service.example.id
+}
+
+resource "render_env_group_link" "worker" {
+  env_group_id = render_env_group.example.id
+  service_id   = render_background_worker.example.id
+}
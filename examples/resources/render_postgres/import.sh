@@ -0,0 +1,2 @@
+# Postgres can be imported by specifying the id.
+terraform import render_postgres.example dpg-cabcdefghijklmnopqest-a
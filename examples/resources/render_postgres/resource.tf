@@ -0,0 +1,45 @@
+# Minimal example of a Render Postgres database
+data "render_owner" "example" {
+  id = "usr-abcdefghijklmnopqest"
+}
+
+resource "render_postgres" "example" {
+  owner_id = data.render_owner.example.id
+  name     = "render-postgres"
+}
+
+# Full example of a Render Postgres database
+resource "render_postgres" "example" {
+  owner_id                  = data.render_owner.example.id
+  name                      = "render-postgres"
+  plan                      = "pro"
+  region                    = "frankfurt"
+  version                   = "16"
+  database_name             = "app"
+  database_user             = "app"
+  high_availability_enabled = true
+  ip_allow_list = [
+    {
+      cidr_block  = "203.0.113.0/24"
+      description = "office"
+    }
+  ]
+  read_replicas = [
+    {
+      name = "render-postgres-replica"
+    }
+  ]
+}
+
+# Wire the connection string into a service
+resource "render_web_service" "example" {
+  owner_id = data.render_owner.example.id
+  name     = "render-web-service"
+  service_details = {
+    env           = "image"
+    num_instances = 1
+  }
+  environment_variables = {
+    DATABASE_URL = { value = render_postgres.example.internal_connection_string }
+  }
+}
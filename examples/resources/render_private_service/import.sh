@@ -0,0 +1,8 @@
+# PrivateService can be imported by specifying the id.
+terraform import render_private_service.example srv-cabcdefghijklmnopqest
+
+# or the owner ID and name, in the form <owner_id>/<name>.
+terraform import render_private_service.example tea-cabcdefghijklmnopqest/example-private-service
+
+# or the slug.
+terraform import render_private_service.example example-private-service
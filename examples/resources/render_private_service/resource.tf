@@ -0,0 +1,44 @@
+# Minimal example of a Render private service
+data "render_owner" "example" {
+  id = "usr-abcdefghijklmnopqest"
+}
+
+resource "render_private_service" "example" {
+  owner_id = data.render_owner.example.id
+  name     = "render-private-service"
+  service_details = {
+    env           = "image"
+    num_instances = 1
+  }
+}
+
+# Full example of a Render private service
+resource "render_private_service" "example" {
+  owner_id = data.render_owner.example.id
+  name     = "render-private-service"
+  image = {
+    owner_id   = data.render_owner.example.id
+    image_path = "docker.io/library/redis:latest"
+  }
+  service_details = {
+    env           = "image"
+    num_instances = 1
+    region        = "frankfurt"
+    plan          = "starter"
+    autoscaling = {
+      enabled = true
+      min     = 1
+      max     = 3
+      criteria = {
+        cpu = {
+          enabled    = true
+          percentage = 50
+        }
+        memory = {
+          enabled    = true
+          percentage = 50
+        }
+      }
+    }
+  }
+}
@@ -0,0 +1,2 @@
+# Redis can be imported by specifying the id.
+terraform import render_redis.example red-cabcdefghijklmnopqest
@@ -0,0 +1,37 @@
+# Minimal example of a Render Key Value (Redis) instance
+data "render_owner" "example" {
+  id = "usr-abcdefghijklmnopqest"
+}
+
+resource "render_redis" "example" {
+  owner_id = data.render_owner.example.id
+  name     = "render-redis"
+}
+
+# Full example of a Render Key Value (Redis) instance
+resource "render_redis" "example" {
+  owner_id         = data.render_owner.example.id
+  name             = "render-redis"
+  plan             = "standard"
+  region           = "frankfurt"
+  maxmemory_policy = "noeviction"
+  ip_allow_list = [
+    {
+      cidr_block  = "203.0.113.0/24"
+      description = "office"
+    }
+  ]
+}
+
+# Wire the connection string into a service
+resource "render_web_service" "example" {
+  owner_id = data.render_owner.example.id
+  name     = "render-web-service"
+  service_details = {
+    env           = "image"
+    num_instances = 1
+  }
+  environment_variables = {
+    REDIS_URL = { value = render_redis.example.internal_connection_string }
+  }
+}
@@ -0,0 +1,2 @@
+# Service environment variable can be imported by specifying the service id and the variable name.
+terraform import render_service_env_var.example srv-cabcdefghijklmnopqest/LOG_LEVEL
@@ -0,0 +1,25 @@
+# Add variables to a service from separate configurations. The service leaves
+# them alone as long as its environment_variables are not set.
+resource "render_web_service" "example" {
+  # ...
+}
+
+resource "render_service_env_var" "log_level" {
+  service_id = render_web_service.example.id
+  key        = "LOG_LEVEL"
+  value      = "info"
+}
+
+# A secret generated by Render
+resource "render_service_env_var" "session_secret" {
+  service_id     = render_web_service.example.id
+  key            = "SESSION_SECRET"
+  generate_value = true
+}
+
+# A token whose value is set and rotated in the dashboard
+resource "render_service_env_var" "api_token" {
+  service_id   = render_web_service.example.id
+  key          = "API_TOKEN"
+  ignore_value = true
+}
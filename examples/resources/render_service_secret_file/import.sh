@@ -0,0 +1,2 @@
+# Service secret file can be imported by specifying the service id and the file name.
+terraform import render_service_secret_file.example srv-cabcdefghijklmnopqest/credentials.json
@@ -0,0 +1,6 @@
+# Add a secret file to a service that doesn't configure its own secret_files
+resource "render_service_secret_file" "credentials" {
+  service_id = render_web_service.example.id
+  name       = "credentials.json"
+  content    = file("credentials.json")
+}
@@ -0,0 +1,2 @@
+# StaticSite can be imported by specifying the id.
+terraform import render_static_site.example srv-cabcdefghijklmnopqest
@@ -0,0 +1,49 @@
+# Minimal example of a Render static site
+data "render_owner" "example" {
+  id = "usr-abcdefghijklmnopqest"
+}
+
+resource "render_static_site" "example" {
+  owner_id = data.render_owner.example.id
+  name     = "render-static-site"
+  repo     = "https://github.com/render-examples/create-react-app"
+  service_details = {
+    build_command = "npm install && npm run build"
+    publish_path  = "build"
+  }
+}
+
+# Full example of a Render static site with redirects, rewrites and headers
+resource "render_static_site" "example" {
+  owner_id = data.render_owner.example.id
+  name     = "render-static-site"
+  repo     = "https://github.com/render-examples/create-react-app"
+  branch   = "main"
+  service_details = {
+    build_command                 = "npm install && npm run build"
+    publish_path                  = "build"
+    pull_request_previews_enabled = "yes"
+    routes = [
+      {
+        type        = "redirect"
+        source      = "/docs/*"
+        destination = "https://docs.example.com/*"
+      },
+      {
+        type        = "rewrite"
+        source      = "/*"
+        destination = "/index.html"
+      }
+    ]
+    headers = [
+      {
+        path  = "/*"
+        name  = "X-Frame-Options"
+        value = "sameorigin"
+      }
+    ]
+  }
+  environment_variables = {
+    NODE_VERSION = { value = "20" }
+  }
+}
@@ -1,2 +1,8 @@
 # WebService can be imported by specifying the id.
 terraform import render_web_service.example srv-cabcdefghijklmnopqest
+
+# or the owner ID and name, in the form <owner_id>/<name>.
+terraform import render_web_service.example tea-cabcdefghijklmnopqest/example-web-service
+
+# or the slug.
+terraform import render_web_service.example example-web-service
@@ -44,3 +44,25 @@ resource "render_web_service" "example" {
     auto_deploy = "yes"
   }
 }
+
+# Example of a Render web service built from a Dockerfile
+resource "render_web_service" "example" {
+  owner_id = data.render_owner.example.id
+  name     = "render-web-service"
+  repo     = "https://github.com/render-examples/express-hello-world"
+  branch   = "main"
+  service_details = {
+    env           = "docker"
+    num_instances = 1
+    docker_details = {
+      docker_context  = "."
+      dockerfile_path = "./Dockerfile"
+      docker_command  = "node server.js"
+    }
+  }
+  wait_for_deploy = true
+  timeouts = {
+    create = "20m"
+    update = "20m"
+  }
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/sonlir/render-client-go"
+
+	"terraform-provider-render/internal/api"
+	"terraform-provider-render/internal/provider"
+)
+
+const generateUsage = `Usage: terraform-provider-render generate [options]
+
+Writes an import block and a render_web_service resource for every web
+service, so that existing services can be brought under Terraform. The API
+key is read from RENDER_API_KEY, and the API URL from RENDER_API_URL.
+
+Secrets are left out of the output unless -include-secrets is set:
+environment variables are written with ignore_value = true, and the contents
+of secret files are read from secrets/<resource>/<file>, which must be
+created before planning.
+
+-include-secrets writes the values of environment variables, including the
+ones Render generated, and the contents of secret files as plain text.
+
+Options:
+`
+
+// runGenerate runs the generate command with the arguments that follow it.
+func runGenerate(args []string) error {
+	flags := flag.NewFlagSet("generate", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), generateUsage)
+		flags.PrintDefaults()
+	}
+
+	var opts provider.GenerateOptions
+	var out string
+	flags.StringVar(&opts.OwnerID, "owner", "", "only generate the services of this `owner ID`")
+	flags.BoolVar(&opts.ImportsOnly, "imports-only", false, "only write the import blocks, for use with terraform plan -generate-config-out")
+	flags.BoolVar(&opts.IncludeSecrets, "include-secrets", false, "write the values of environment variables and secret files as plain text")
+	flags.StringVar(&out, "out", "", "the `file` to write to instead of standard output")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	apiKey := os.Getenv("RENDER_API_KEY")
+	if apiKey == "" {
+		return fmt.Errorf("the RENDER_API_KEY environment variable must be set")
+	}
+	apiURL := render.HostURL
+	if v := os.Getenv("RENDER_API_URL"); v != "" {
+		apiURL = strings.TrimSuffix(v, "/")
+	}
+
+	client, err := render.NewClient(&apiKey, &apiURL)
+	if err != nil {
+		return err
+	}
+	client.HTTPClient = api.NewHTTPClient(api.HTTPClientOptions{
+		Timeout:      30 * time.Second,
+		UserAgent:    "terraform-provider-render/" + version,
+		MaxRetries:   3,
+		MaxRetryWait: time.Minute,
+	})
+
+	var w io.Writer = os.Stdout
+	if out != "" {
+		file, err := os.Create(out)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		w = file
+	}
+
+	return provider.GenerateConfig(api.NewClient(client), opts, w)
+}
@@ -0,0 +1,65 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/sonlir/render-client-go"
+)
+
+// Client extends render.Client with the Render API endpoints that the
+// upstream client does not cover yet.
+type Client struct {
+	*render.Client
+}
+
+func NewClient(client *render.Client) *Client {
+	return &Client{Client: client}
+}
+
+func (c *Client) doRequest(method, url string, data interface{}, jsonSchema interface{}) error {
+	buf := new(bytes.Buffer)
+	if data != nil {
+		jsonData, err := json.Marshal(data)
+		if err != nil {
+			return err
+		}
+		buf = bytes.NewBuffer(jsonData)
+	}
+
+	req, err := http.NewRequest(method, url, buf)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Add("accept", "application/json")
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Authorization", "Bearer "+c.APIKey)
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusCreated && res.StatusCode != http.StatusAccepted {
+		return fmt.Errorf("status code: %d, details: %s", res.StatusCode, body)
+	}
+
+	if jsonSchema != nil && len(body) > 0 {
+		err = json.Unmarshal(body, &jsonSchema)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
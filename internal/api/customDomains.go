@@ -0,0 +1,12 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const customDomainsPath = "custom-domains"
+
+func (c *Client) VerifyCustomDomain(serviceId, idOrName string) error {
+	return c.doRequest(http.MethodPost, fmt.Sprintf("%s/%s/%s/%s/%s/verify", c.HostURL, servicesPath, serviceId, customDomainsPath, idOrName), nil, nil)
+}
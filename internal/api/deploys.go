@@ -0,0 +1,104 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const deploysPath = "deploys"
+
+type Deploy struct {
+	ID         string        `json:"id,omitempty"`
+	Commit     *DeployCommit `json:"commit,omitempty"`
+	CreatedAt  string        `json:"createdAt,omitempty"`
+	FinishedAt string        `json:"finishedAt,omitempty"`
+	Image      *DeployImage  `json:"image,omitempty"`
+	Status     string        `json:"status,omitempty"`
+	Trigger    string        `json:"trigger,omitempty"`
+	UpdatedAt  string        `json:"updatedAt,omitempty"`
+}
+
+type DeployCommit struct {
+	ID        string `json:"id,omitempty"`
+	Message   string `json:"message,omitempty"`
+	CreatedAt string `json:"createdAt,omitempty"`
+}
+
+type DeployImage struct {
+	Ref                string `json:"ref,omitempty"`
+	Sha                string `json:"sha,omitempty"`
+	RegistryCredential string `json:"registryCredential,omitempty"`
+}
+
+// DeployData is the request body used to trigger a deploy.
+type DeployData struct {
+	ClearCache string `json:"clearCache,omitempty"`
+	CommitID   string `json:"commitId,omitempty"`
+	ImageURL   string `json:"imageUrl,omitempty"`
+}
+
+type Deploys struct {
+	Deploy `json:"deploy"`
+}
+
+type GetDeploysArgs struct {
+	Limit int
+}
+
+// Deploy statuses after which a deploy no longer changes.
+var DeployTerminalStatuses = map[string]bool{
+	"live":              true,
+	"deactivated":       true,
+	"build_failed":      true,
+	"update_failed":     true,
+	"canceled":          true,
+	"pre_deploy_failed": true,
+}
+
+func (c *Client) GetDeploys(serviceId string, args *GetDeploysArgs) ([]Deploy, error) {
+	var deploys []Deploys
+	parameters := url.Values{}
+	url, err := url.Parse(fmt.Sprintf("%s/%s/%s/%s", c.HostURL, servicesPath, serviceId, deploysPath))
+	if err != nil {
+		return nil, err
+	}
+	if args != nil {
+		if args.Limit != 0 {
+			parameters.Add("limit", strconv.Itoa(args.Limit))
+		}
+	}
+	url.RawQuery = parameters.Encode()
+
+	err = c.doRequest(http.MethodGet, url.String(), nil, &deploys)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []Deploy
+	for _, deploy := range deploys {
+		result = append(result, deploy.Deploy)
+	}
+	return result, nil
+}
+
+func (c *Client) GetDeploy(serviceId, id string) (*Deploy, error) {
+	deploy := Deploy{}
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("%s/%s/%s/%s/%s", c.HostURL, servicesPath, serviceId, deploysPath, id), nil, &deploy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &deploy, nil
+}
+
+func (c *Client) CreateDeploy(serviceId string, data DeployData) (*Deploy, error) {
+	deploy := Deploy{}
+	err := c.doRequest(http.MethodPost, fmt.Sprintf("%s/%s/%s/%s", c.HostURL, servicesPath, serviceId, deploysPath), data, &deploy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &deploy, nil
+}
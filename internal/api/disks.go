@@ -0,0 +1,196 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/sonlir/render-client-go"
+)
+
+const (
+	disksPath     = "disks"
+	snapshotsPath = "snapshots"
+)
+
+type Disk struct {
+	ID        string `json:"id,omitempty"`
+	CreatedAt string `json:"createdAt,omitempty"`
+	MountPath string `json:"mountPath,omitempty"`
+	Name      string `json:"name,omitempty"`
+	ServiceID string `json:"serviceId,omitempty"`
+	SizeGB    int64  `json:"sizeGB,omitempty"`
+	UpdatedAt string `json:"updatedAt,omitempty"`
+}
+
+// DiskData is the request body used to create and update a disk.
+type DiskData struct {
+	MountPath string `json:"mountPath,omitempty"`
+	Name      string `json:"name,omitempty"`
+	ServiceID string `json:"serviceId,omitempty"`
+	SizeGB    int64  `json:"sizeGB,omitempty"`
+}
+
+type DiskSnapshot struct {
+	CreatedAt   string `json:"createdAt"`
+	SnapshotKey string `json:"snapshotKey"`
+}
+
+type Disks struct {
+	Disk `json:"disk"`
+}
+
+type GetDisksArgs struct {
+	Name      string
+	OwnerID   string
+	ServiceID string
+}
+
+func (c *Client) GetDisks(args *GetDisksArgs) ([]Disk, error) {
+	var disks []Disks
+	parameters := url.Values{}
+	url, err := url.Parse(fmt.Sprintf("%s/%s", c.HostURL, disksPath))
+	if err != nil {
+		return nil, err
+	}
+	if args != nil {
+		if args.Name != "" {
+			parameters.Add("name", args.Name)
+		}
+		if args.OwnerID != "" {
+			parameters.Add("ownerId", args.OwnerID)
+		}
+		if args.ServiceID != "" {
+			parameters.Add("serviceId", args.ServiceID)
+		}
+	}
+	url.RawQuery = parameters.Encode()
+
+	err = c.doRequest(http.MethodGet, url.String(), nil, &disks)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []Disk
+	for _, disk := range disks {
+		result = append(result, disk.Disk)
+	}
+	return result, nil
+}
+
+func (c *Client) GetDisk(id string) (*Disk, error) {
+	disk := Disk{}
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("%s/%s/%s", c.HostURL, disksPath, id), nil, &disk)
+	if err != nil {
+		return nil, err
+	}
+
+	return &disk, nil
+}
+
+func (c *Client) CreateDisk(data DiskData) (*Disk, error) {
+	disk := Disk{}
+
+	disks, err := c.GetDisks(&GetDisksArgs{ServiceID: data.ServiceID})
+	if err != nil {
+		return nil, err
+	}
+	if disks != nil {
+		return nil, fmt.Errorf("the service `%s` already has a disk attached", data.ServiceID)
+	}
+
+	err = c.doRequest(http.MethodPost, fmt.Sprintf("%s/%s", c.HostURL, disksPath), data, &disk)
+	if err != nil {
+		return nil, err
+	}
+
+	return &disk, nil
+}
+
+func (c *Client) UpdateDisk(id string, data DiskData) (*Disk, error) {
+	disk := Disk{}
+
+	// The service a disk is attached to cannot be changed.
+	data.ServiceID = ""
+
+	err := c.doRequest(http.MethodPatch, fmt.Sprintf("%s/%s/%s", c.HostURL, disksPath, id), data, &disk)
+	if err != nil {
+		return nil, err
+	}
+
+	return &disk, nil
+}
+
+func (c *Client) DeleteDisk(id string) error {
+	return c.doRequest(http.MethodDelete, fmt.Sprintf("%s/%s/%s", c.HostURL, disksPath, id), nil, nil)
+}
+
+func (c *Client) GetDiskSnapshots(id string) ([]DiskSnapshot, error) {
+	var snapshots []DiskSnapshot
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("%s/%s/%s/%s", c.HostURL, disksPath, id, snapshotsPath), nil, &snapshots)
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshots, nil
+}
+
+// GetServiceDisk returns the disk attached to service, or nil if there is
+// none. Service responses only include the ID and name of the disk.
+func (c *Client) GetServiceDisk(service *render.Service) (*render.Disk, error) {
+	if service.ServiceDetails.Disk == nil || service.ServiceDetails.Disk.Id == "" {
+		return nil, nil
+	}
+
+	disk, err := c.GetDisk(service.ServiceDetails.Disk.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &render.Disk{
+		Id:        disk.ID,
+		Name:      disk.Name,
+		MountPath: disk.MountPath,
+		SizeGB:    disk.SizeGB,
+	}, nil
+}
+
+// UpdateServiceDisk makes the disk attached to a service match data. The disk
+// is created if the service has none, and deleted if data is nil.
+func (c *Client) UpdateServiceDisk(serviceId string, data *render.Disk) (*render.Disk, error) {
+	disks, err := c.GetDisks(&GetDisksArgs{ServiceID: serviceId})
+	if err != nil {
+		return nil, err
+	}
+
+	var disk *Disk
+	switch {
+	case data == nil && disks == nil:
+		return nil, nil
+	case data == nil:
+		return nil, c.DeleteDisk(disks[0].ID)
+	case disks == nil:
+		disk, err = c.CreateDisk(DiskData{
+			MountPath: data.MountPath,
+			Name:      data.Name,
+			ServiceID: serviceId,
+			SizeGB:    data.SizeGB,
+		})
+	default:
+		disk, err = c.UpdateDisk(disks[0].ID, DiskData{
+			MountPath: data.MountPath,
+			Name:      data.Name,
+			SizeGB:    data.SizeGB,
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &render.Disk{
+		Id:        disk.ID,
+		Name:      disk.Name,
+		MountPath: disk.MountPath,
+		SizeGB:    disk.SizeGB,
+	}, nil
+}
@@ -0,0 +1,176 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/sonlir/render-client-go"
+)
+
+const (
+	envGroupsPath   = "env-groups"
+	envVarsPath     = "env-vars"
+	secretFilesPath = "secret-files"
+)
+
+type EnvGroup struct {
+	ID           string                       `json:"id,omitempty"`
+	CreatedAt    string                       `json:"createdAt,omitempty"`
+	EnvVars      []render.EnvironmentVariable `json:"envVars,omitempty"`
+	Name         string                       `json:"name,omitempty"`
+	OwnerID      string                       `json:"ownerId,omitempty"`
+	SecretFiles  []SecretFile                 `json:"secretFiles,omitempty"`
+	ServiceLinks []EnvGroupServiceLink        `json:"serviceLinks,omitempty"`
+	UpdatedAt    string                       `json:"updatedAt,omitempty"`
+}
+
+// EnvGroupData is the request body used to create an environment group.
+type EnvGroupData struct {
+	EnvVars     []EnvVarInput `json:"envVars"`
+	Name        string        `json:"name"`
+	OwnerID     string        `json:"ownerId"`
+	SecretFiles []SecretFile  `json:"secretFiles"`
+}
+
+type EnvGroupServiceLink struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type SecretFile struct {
+	Name    string `json:"name,omitempty"`
+	Content string `json:"content"`
+}
+
+type EnvGroups struct {
+	EnvGroup `json:"envGroup"`
+}
+
+type GetEnvGroupsArgs struct {
+	Name    string
+	OwnerID string
+}
+
+func (c *Client) GetEnvGroups(args *GetEnvGroupsArgs) ([]EnvGroup, error) {
+	var envGroups []EnvGroups
+	parameters := url.Values{}
+	url, err := url.Parse(fmt.Sprintf("%s/%s", c.HostURL, envGroupsPath))
+	if err != nil {
+		return nil, err
+	}
+	if args != nil {
+		if args.Name != "" {
+			parameters.Add("name", args.Name)
+		}
+		if args.OwnerID != "" {
+			parameters.Add("ownerId", args.OwnerID)
+		}
+	}
+	url.RawQuery = parameters.Encode()
+
+	err = c.doRequest(http.MethodGet, url.String(), nil, &envGroups)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []EnvGroup
+	for _, envGroup := range envGroups {
+		result = append(result, envGroup.EnvGroup)
+	}
+	return result, nil
+}
+
+func (c *Client) GetEnvGroup(id string) (*EnvGroup, error) {
+	envGroup := EnvGroup{}
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("%s/%s/%s", c.HostURL, envGroupsPath, id), nil, &envGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	return &envGroup, nil
+}
+
+func (c *Client) CreateEnvGroup(data EnvGroupData) (*EnvGroup, error) {
+	envGroup := EnvGroup{}
+
+	envGroups, err := c.GetEnvGroups(&GetEnvGroupsArgs{Name: data.Name, OwnerID: data.OwnerID})
+	if err != nil {
+		return nil, err
+	}
+	if envGroups != nil {
+		return nil, fmt.Errorf("the name `%s` is already in use. Please use a different name", data.Name)
+	}
+
+	err = c.doRequest(http.MethodPost, fmt.Sprintf("%s/%s", c.HostURL, envGroupsPath), data, &envGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetEnvGroup(envGroup.ID)
+}
+
+// UpdateEnvGroup renames the group and makes its variables and secret files
+// match data, removing the ones that are no longer present.
+func (c *Client) UpdateEnvGroup(id string, data EnvGroupData) (*EnvGroup, error) {
+	current, err := c.GetEnvGroup(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if current.Name != data.Name {
+		err = c.doRequest(http.MethodPatch, fmt.Sprintf("%s/%s/%s", c.HostURL, envGroupsPath, id), map[string]string{"name": data.Name}, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	keys := map[string]bool{}
+	for _, envVar := range data.EnvVars {
+		keys[envVar.Key] = true
+		err = c.doRequest(http.MethodPut, fmt.Sprintf("%s/%s/%s/%s/%s", c.HostURL, envGroupsPath, id, envVarsPath, url.PathEscape(envVar.Key)), envVar.EnvVarValue, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, envVar := range current.EnvVars {
+		if !keys[envVar.Key] {
+			err = c.doRequest(http.MethodDelete, fmt.Sprintf("%s/%s/%s/%s/%s", c.HostURL, envGroupsPath, id, envVarsPath, url.PathEscape(envVar.Key)), nil, nil)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	names := map[string]bool{}
+	for _, secretFile := range data.SecretFiles {
+		names[secretFile.Name] = true
+		err = c.doRequest(http.MethodPut, fmt.Sprintf("%s/%s/%s/%s/%s", c.HostURL, envGroupsPath, id, secretFilesPath, url.PathEscape(secretFile.Name)), map[string]string{"content": secretFile.Content}, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, secretFile := range current.SecretFiles {
+		if !names[secretFile.Name] {
+			err = c.doRequest(http.MethodDelete, fmt.Sprintf("%s/%s/%s/%s/%s", c.HostURL, envGroupsPath, id, secretFilesPath, url.PathEscape(secretFile.Name)), nil, nil)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return c.GetEnvGroup(id)
+}
+
+func (c *Client) DeleteEnvGroup(id string) error {
+	return c.doRequest(http.MethodDelete, fmt.Sprintf("%s/%s/%s", c.HostURL, envGroupsPath, id), nil, nil)
+}
+
+func (c *Client) LinkEnvGroupService(id, serviceId string) error {
+	return c.doRequest(http.MethodPost, fmt.Sprintf("%s/%s/%s/%s/%s", c.HostURL, envGroupsPath, id, servicesPath, serviceId), nil, nil)
+}
+
+func (c *Client) UnlinkEnvGroupService(id, serviceId string) error {
+	return c.doRequest(http.MethodDelete, fmt.Sprintf("%s/%s/%s/%s/%s", c.HostURL, envGroupsPath, id, servicesPath, serviceId), nil, nil)
+}
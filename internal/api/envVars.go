@@ -0,0 +1,71 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/sonlir/render-client-go"
+)
+
+// EnvVarValue is the value of an environment variable in a request. Render
+// generates a random value for variables with GenerateValue set, in which
+// case Value must be nil.
+type EnvVarValue struct {
+	Value         *string `json:"value,omitempty"`
+	GenerateValue bool    `json:"generateValue,omitempty"`
+}
+
+// EnvVarInput is an environment variable in a request. Unlike
+// render.EnvironmentVariable it can ask Render to generate the value.
+type EnvVarInput struct {
+	Key string `json:"key"`
+	EnvVarValue
+}
+
+// UpdateServiceEnvVars replaces the environment variables of a service.
+func (c *Client) UpdateServiceEnvVars(serviceId string, data []EnvVarInput) ([]render.EnvironmentVariable, error) {
+	environmentVariables := []render.EnvironmentVariables{}
+	err := c.doRequest(http.MethodPut, fmt.Sprintf("%s/%s/%s/%s", c.HostURL, servicesPath, serviceId, envVarsPath), data, &environmentVariables)
+	if err != nil {
+		return nil, err
+	}
+
+	return render.EnvironmentVariablesToSlice(environmentVariables), nil
+}
+
+// updateEnvVars replaces the environment variables of a service, unless data
+// is nil, and returns the resulting variables.
+func (c *Client) updateEnvVars(serviceId string, data []EnvVarInput) ([]render.EnvironmentVariable, error) {
+	if data == nil {
+		return c.GetEnvironmentVariables(serviceId)
+	}
+	return c.UpdateServiceEnvVars(serviceId, data)
+}
+
+// GetServiceEnvVar returns a single environment variable of a service.
+func (c *Client) GetServiceEnvVar(serviceId, key string) (*render.EnvironmentVariable, error) {
+	envVar := render.EnvironmentVariable{}
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("%s/%s/%s/%s/%s", c.HostURL, servicesPath, serviceId, envVarsPath, url.PathEscape(key)), nil, &envVar)
+	if err != nil {
+		return nil, err
+	}
+
+	return &envVar, nil
+}
+
+// UpdateServiceEnvVar creates or updates a single environment variable of a
+// service, leaving the other variables alone.
+func (c *Client) UpdateServiceEnvVar(serviceId, key string, data EnvVarValue) (*render.EnvironmentVariable, error) {
+	envVar := render.EnvironmentVariable{}
+	err := c.doRequest(http.MethodPut, fmt.Sprintf("%s/%s/%s/%s/%s", c.HostURL, servicesPath, serviceId, envVarsPath, url.PathEscape(key)), data, &envVar)
+	if err != nil {
+		return nil, err
+	}
+
+	return &envVar, nil
+}
+
+func (c *Client) DeleteServiceEnvVar(serviceId, key string) error {
+	return c.doRequest(http.MethodDelete, fmt.Sprintf("%s/%s/%s/%s/%s", c.HostURL, servicesPath, serviceId, envVarsPath, url.PathEscape(key)), nil, nil)
+}
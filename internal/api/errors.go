@@ -0,0 +1,9 @@
+package api
+
+import "strings"
+
+// IsNotFound reports whether err is a 404 response from the Render API. The
+// upstream client only returns formatted errors, so the message is matched.
+func IsNotFound(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "status code: 404,")
+}
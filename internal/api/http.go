@@ -0,0 +1,202 @@
+package api
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// HTTPClientOptions configures the HTTP client shared by all resources and
+// data sources.
+type HTTPClientOptions struct {
+	// Timeout limits the time a single attempt of a request may take, zero
+	// means no limit. Time spent waiting between retries is not counted.
+	Timeout time.Duration
+	// UserAgent is sent with every request.
+	UserAgent string
+	// MaxRetries is how many times a failed request is retried.
+	MaxRetries int
+	// MaxRetryWait caps the time to wait before a single retry. A response
+	// asking to wait longer is returned without retrying.
+	MaxRetryWait time.Duration
+	// RequestsPerMinute throttles requests, retries included, zero means no
+	// limit.
+	RequestsPerMinute int
+}
+
+func NewHTTPClient(opts HTTPClientOptions) *http.Client {
+	var transport http.RoundTripper = http.DefaultTransport
+	if opts.Timeout > 0 {
+		transport = &timeoutTransport{timeout: opts.Timeout, next: transport}
+	}
+	if opts.RequestsPerMinute > 0 {
+		transport = &rateLimitTransport{limiter: newRateLimiter(opts.RequestsPerMinute), next: transport}
+	}
+	if opts.MaxRetries > 0 {
+		transport = &retryTransport{maxRetries: opts.MaxRetries, maxWait: opts.MaxRetryWait, next: transport}
+	}
+	if opts.UserAgent != "" {
+		transport = &userAgentTransport{userAgent: opts.UserAgent, next: transport}
+	}
+
+	return &http.Client{
+		Transport: transport,
+	}
+}
+
+type timeoutTransport struct {
+	timeout time.Duration
+	next    http.RoundTripper
+}
+
+func (t *timeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+	res, err := t.next.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	res.Body = &cancelBody{ReadCloser: res.Body, cancel: cancel}
+	return res, nil
+}
+
+// cancelBody releases the attempt's context once the body has been read.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+type userAgentTransport struct {
+	userAgent string
+	next      http.RoundTripper
+}
+
+func (t *userAgentTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("User-Agent", t.userAgent)
+	return t.next.RoundTrip(req)
+}
+
+const retryBaseWait = time.Second
+
+// retryTransport retries requests that were rate limited, and requests with
+// idempotent methods that failed with a server or network error. A rate
+// limited request was never processed, so it is safe to retry any method.
+type retryTransport struct {
+	maxRetries int
+	maxWait    time.Duration
+	next       http.RoundTripper
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+
+		res, err := t.next.RoundTrip(req)
+		if attempt >= t.maxRetries || !shouldRetry(req, res, err) {
+			return res, err
+		}
+		if req.Body != nil && req.GetBody == nil {
+			return res, err
+		}
+
+		wait, ok := t.backoff(attempt, res)
+		if !ok {
+			return res, err
+		}
+		if res != nil {
+			res.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func shouldRetry(req *http.Request, res *http.Response, err error) bool {
+	if res != nil && res.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	if !isIdempotent(req.Method) {
+		return false
+	}
+	if err != nil {
+		return req.Context().Err() == nil
+	}
+
+	switch res.StatusCode {
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// backoff returns how long to wait before the next attempt. The server's
+// Retry-After or Ratelimit-Reset header wins over exponential backoff, which
+// is capped at maxWait. It returns false when the server asks to wait longer
+// than maxWait, as retrying any sooner would only be rejected again.
+func (t *retryTransport) backoff(attempt int, res *http.Response) (time.Duration, bool) {
+	if wait, ok := retryAfter(res); ok {
+		return wait, t.maxWait <= 0 || wait <= t.maxWait
+	}
+
+	wait := retryBaseWait << attempt
+	// A random wait between half and all of the backoff spreads out clients
+	// that were throttled at the same time.
+	wait = wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
+	if t.maxWait > 0 && wait > t.maxWait {
+		wait = t.maxWait
+	}
+	return wait, true
+}
+
+func retryAfter(res *http.Response) (time.Duration, bool) {
+	if res == nil {
+		return 0, false
+	}
+
+	if v := res.Header.Get("Retry-After"); v != "" {
+		if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second, true
+		}
+		if date, err := http.ParseTime(v); err == nil {
+			return max(time.Until(date), 0), true
+		}
+	}
+	if v := res.Header.Get("Ratelimit-Reset"); v != "" {
+		if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second, true
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,119 @@
+package api
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRetryTransport(t *testing.T) {
+	tests := map[string]struct {
+		method     string
+		statuses   []int
+		retryAfter string
+		wantCalls  int
+		wantCode   int
+	}{
+		"get retried on server error":    {method: http.MethodGet, statuses: []int{503, 502, 200}, wantCalls: 3, wantCode: 200},
+		"post not retried on 5xx":        {method: http.MethodPost, statuses: []int{500, 200}, wantCalls: 1, wantCode: 500},
+		"patch not retried on 5xx":       {method: http.MethodPatch, statuses: []int{503, 200}, wantCalls: 1, wantCode: 503},
+		"post retried when rate limited": {method: http.MethodPost, statuses: []int{429, 201}, wantCalls: 2, wantCode: 201},
+		"client error not retried":       {method: http.MethodGet, statuses: []int{404, 200}, wantCalls: 1, wantCode: 404},
+		"gives up after max retries":     {method: http.MethodDelete, statuses: []int{500, 500, 500, 500}, wantCalls: 3, wantCode: 500},
+		"long rate limit not retried":    {method: http.MethodGet, statuses: []int{429, 200}, retryAfter: "60", wantCalls: 1, wantCode: 429},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			calls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				if string(body) != `{"name":"test"}` {
+					t.Errorf("attempt %d: unexpected body: %q", calls, body)
+				}
+				retryAfter := test.retryAfter
+				if retryAfter == "" {
+					retryAfter = "0"
+				}
+				w.Header().Set("Retry-After", retryAfter)
+				w.WriteHeader(test.statuses[calls])
+				calls++
+			}))
+			defer server.Close()
+
+			client := NewHTTPClient(HTTPClientOptions{MaxRetries: 2, MaxRetryWait: time.Second})
+			req, err := http.NewRequest(test.method, server.URL, bytes.NewBufferString(`{"name":"test"}`))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			res, err := client.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res.Body.Close()
+
+			if calls != test.wantCalls {
+				t.Errorf("expected %d calls, got %d", test.wantCalls, calls)
+			}
+			if res.StatusCode != test.wantCode {
+				t.Errorf("expected status %d, got %d", test.wantCode, res.StatusCode)
+			}
+		})
+	}
+}
+
+func TestRetryTransportBackoff(t *testing.T) {
+	transport := &retryTransport{maxWait: time.Minute}
+
+	tests := map[string]struct {
+		attempt int
+		header  http.Header
+		min     time.Duration
+		max     time.Duration
+		retry   bool
+	}{
+		"retry after seconds":        {attempt: 3, header: http.Header{"Retry-After": {"7"}}, min: 7 * time.Second, max: 7 * time.Second, retry: true},
+		"rate limit reset":           {attempt: 3, header: http.Header{"Ratelimit-Reset": {"12"}}, min: 12 * time.Second, max: 12 * time.Second, retry: true},
+		"longer than max wait":       {attempt: 3, header: http.Header{"Retry-After": {"3600"}}, min: time.Hour, max: time.Hour, retry: false},
+		"exponential backoff":        {attempt: 3, header: http.Header{}, min: 4 * time.Second, max: 8 * time.Second, retry: true},
+		"backoff capped by max wait": {attempt: 10, header: http.Header{}, min: time.Minute, max: time.Minute, retry: true},
+	}
+
+	for name, test := range tests {
+		wait, retry := transport.backoff(test.attempt, &http.Response{Header: test.header})
+		if wait < test.min || wait > test.max {
+			t.Errorf("%s: expected a wait between %s and %s, got %s", name, test.min, test.max, wait)
+		}
+		if retry != test.retry {
+			t.Errorf("%s: expected retry to be %t, got %t", name, test.retry, retry)
+		}
+	}
+}
+
+func TestRetryTransportTimeout(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			<-r.Context().Done()
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientOptions{Timeout: 50 * time.Millisecond, MaxRetries: 1, MaxRetryWait: time.Millisecond})
+	res, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+
+	if calls != 2 {
+		t.Errorf("expected the timed out attempt to be retried, got %d calls", calls)
+	}
+}
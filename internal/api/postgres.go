@@ -0,0 +1,161 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/sonlir/render-client-go"
+)
+
+const postgresPath = "postgres"
+
+type Postgres struct {
+	ID                      string                `json:"id,omitempty"`
+	CreatedAt               string                `json:"createdAt,omitempty"`
+	DatabaseName            string                `json:"databaseName,omitempty"`
+	DatabaseUser            string                `json:"databaseUser,omitempty"`
+	HighAvailabilityEnabled bool                  `json:"highAvailabilityEnabled,omitempty"`
+	IPAllowList             []IPAllowListEntry    `json:"ipAllowList,omitempty"`
+	Name                    string                `json:"name,omitempty"`
+	Owner                   *render.Owner         `json:"owner,omitempty"`
+	Plan                    string                `json:"plan,omitempty"`
+	ReadReplicas            []PostgresReadReplica `json:"readReplicas,omitempty"`
+	Region                  string                `json:"region,omitempty"`
+	Status                  string                `json:"status,omitempty"`
+	UpdatedAt               string                `json:"updatedAt,omitempty"`
+	Version                 string                `json:"version,omitempty"`
+}
+
+// PostgresData is the request body used to create and update a Postgres
+// instance.
+type PostgresData struct {
+	DatabaseName           string                `json:"databaseName,omitempty"`
+	DatabaseUser           string                `json:"databaseUser,omitempty"`
+	EnableHighAvailability bool                  `json:"enableHighAvailability"`
+	IPAllowList            []IPAllowListEntry    `json:"ipAllowList"`
+	Name                   string                `json:"name,omitempty"`
+	OwnerID                string                `json:"ownerId,omitempty"`
+	Plan                   string                `json:"plan,omitempty"`
+	ReadReplicas           []PostgresReadReplica `json:"readReplicas"`
+	Region                 string                `json:"region,omitempty"`
+	Version                string                `json:"version,omitempty"`
+}
+
+type IPAllowListEntry struct {
+	CIDRBlock   string `json:"cidrBlock"`
+	Description string `json:"description"`
+}
+
+type PostgresReadReplica struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name"`
+}
+
+type PostgresConnectionInfo struct {
+	Password                 string `json:"password"`
+	InternalConnectionString string `json:"internalConnectionString"`
+	ExternalConnectionString string `json:"externalConnectionString"`
+	PSQLCommand              string `json:"psqlCommand"`
+}
+
+type PostgresInstances struct {
+	Postgres `json:"postgres"`
+}
+
+type GetPostgresInstancesArgs struct {
+	Name    string
+	OwnerID string
+}
+
+func (c *Client) GetPostgresInstances(args *GetPostgresInstancesArgs) ([]Postgres, error) {
+	var postgresInstances []PostgresInstances
+	parameters := url.Values{}
+	url, err := url.Parse(fmt.Sprintf("%s/%s", c.HostURL, postgresPath))
+	if err != nil {
+		return nil, err
+	}
+	if args != nil {
+		if args.Name != "" {
+			parameters.Add("name", args.Name)
+		}
+		if args.OwnerID != "" {
+			parameters.Add("ownerId", args.OwnerID)
+		}
+	}
+	url.RawQuery = parameters.Encode()
+
+	err = c.doRequest(http.MethodGet, url.String(), nil, &postgresInstances)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []Postgres
+	for _, postgres := range postgresInstances {
+		result = append(result, postgres.Postgres)
+	}
+	return result, nil
+}
+
+func (c *Client) GetPostgres(id string) (*Postgres, error) {
+	postgres := Postgres{}
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("%s/%s/%s", c.HostURL, postgresPath, id), nil, &postgres)
+	if err != nil {
+		return nil, err
+	}
+
+	return &postgres, nil
+}
+
+func (c *Client) GetPostgresConnectionInfo(id string) (*PostgresConnectionInfo, error) {
+	connectionInfo := PostgresConnectionInfo{}
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("%s/%s/%s/connection-info", c.HostURL, postgresPath, id), nil, &connectionInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &connectionInfo, nil
+}
+
+func (c *Client) CreatePostgres(data PostgresData) (*Postgres, error) {
+	postgres := Postgres{}
+
+	postgresInstances, err := c.GetPostgresInstances(&GetPostgresInstancesArgs{Name: data.Name, OwnerID: data.OwnerID})
+	if err != nil {
+		return nil, err
+	}
+	if postgresInstances != nil {
+		return nil, fmt.Errorf("the name `%s` is already in use. Please use a different name", data.Name)
+	}
+
+	err = c.doRequest(http.MethodPost, fmt.Sprintf("%s/%s", c.HostURL, postgresPath), data, &postgres)
+	if err != nil {
+		return nil, err
+	}
+
+	return &postgres, nil
+}
+
+func (c *Client) UpdatePostgres(id string, data PostgresData) (*Postgres, error) {
+	postgres := Postgres{}
+
+	// Only these fields can be changed on an existing instance.
+	patch := PostgresData{
+		Name:                   data.Name,
+		Plan:                   data.Plan,
+		EnableHighAvailability: data.EnableHighAvailability,
+		IPAllowList:            data.IPAllowList,
+		ReadReplicas:           data.ReadReplicas,
+	}
+
+	err := c.doRequest(http.MethodPatch, fmt.Sprintf("%s/%s/%s", c.HostURL, postgresPath, id), patch, &postgres)
+	if err != nil {
+		return nil, err
+	}
+
+	return &postgres, nil
+}
+
+func (c *Client) DeletePostgres(id string) error {
+	return c.doRequest(http.MethodDelete, fmt.Sprintf("%s/%s/%s", c.HostURL, postgresPath, id), nil, nil)
+}
@@ -0,0 +1,73 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// rateLimiter is a token bucket that refills at the configured number of
+// requests per minute. It holds a tenth of a minute's requests so a burst
+// can't use up the account quota on its own.
+type rateLimiter struct {
+	mu       sync.Mutex
+	rate     float64 // tokens per second
+	capacity float64
+	tokens   float64
+	last     time.Time
+}
+
+func newRateLimiter(requestsPerMinute int) *rateLimiter {
+	capacity := max(float64(requestsPerMinute)/10, 1)
+	return &rateLimiter{
+		rate:     float64(requestsPerMinute) / 60,
+		capacity: capacity,
+		tokens:   capacity,
+		last:     time.Now(),
+	}
+}
+
+// Wait blocks until a request may be sent or the context is done.
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	wait := l.reserve(time.Now())
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// reserve takes a token at now, and returns how long to wait until it is
+// available.
+func (l *rateLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.tokens = min(l.tokens+now.Sub(l.last).Seconds()*l.rate, l.capacity)
+	l.last = now
+	// Taking the token up front queues concurrent callers behind each other.
+	l.tokens--
+	return max(time.Duration(-l.tokens/l.rate*float64(time.Second)), 0)
+}
+
+type rateLimitTransport struct {
+	limiter *rateLimiter
+	next    http.RoundTripper
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+	return t.next.RoundTrip(req)
+}
@@ -0,0 +1,58 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter(t *testing.T) {
+	// 600 requests per minute is 10 per second with a burst of 60.
+	limiter := newRateLimiter(600)
+	start := limiter.last
+
+	for i := 0; i < 60; i++ {
+		if wait := limiter.reserve(start); wait > 0 {
+			t.Fatalf("request %d: expected the burst to pass without waiting, got %s", i, wait)
+		}
+	}
+
+	tests := []struct {
+		name string
+		at   time.Duration
+		wait time.Duration
+	}{
+		{name: "bucket empty", at: 0, wait: 100 * time.Millisecond},
+		{name: "queued behind the previous request", at: 0, wait: 200 * time.Millisecond},
+		{name: "tokens refilled in the meantime", at: 300 * time.Millisecond, wait: 0},
+		{name: "full bucket after a long pause", at: time.Hour, wait: 0},
+	}
+
+	for _, test := range tests {
+		wait := limiter.reserve(start.Add(test.at))
+		if diff := wait - test.wait; diff < -time.Microsecond || diff > time.Microsecond {
+			t.Errorf("%s: expected to wait %s, got %s", test.name, test.wait, wait)
+		}
+	}
+
+	// The long pause refilled the bucket up to its capacity, not beyond.
+	for i := 1; i < 60; i++ {
+		limiter.reserve(start.Add(time.Hour))
+	}
+	if wait := limiter.reserve(start.Add(time.Hour)); wait <= 0 {
+		t.Errorf("expected the bucket to hold at most 60 tokens, got no wait")
+	}
+}
+
+func TestRateLimiterCancel(t *testing.T) {
+	limiter := newRateLimiter(1)
+	if err := limiter.Wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := limiter.Wait(ctx); err == nil {
+		t.Error("expected an error when the context is done before a token is available")
+	}
+}
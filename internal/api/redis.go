@@ -0,0 +1,141 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/sonlir/render-client-go"
+)
+
+const redisPath = "redis"
+
+type Redis struct {
+	ID              string             `json:"id,omitempty"`
+	CreatedAt       string             `json:"createdAt,omitempty"`
+	IPAllowList     []IPAllowListEntry `json:"ipAllowList,omitempty"`
+	MaxmemoryPolicy string             `json:"maxmemoryPolicy,omitempty"`
+	Name            string             `json:"name,omitempty"`
+	Owner           *render.Owner      `json:"owner,omitempty"`
+	Plan            string             `json:"plan,omitempty"`
+	Region          string             `json:"region,omitempty"`
+	Status          string             `json:"status,omitempty"`
+	UpdatedAt       string             `json:"updatedAt,omitempty"`
+	Version         string             `json:"version,omitempty"`
+}
+
+// RedisData is the request body used to create and update a Redis instance.
+type RedisData struct {
+	IPAllowList     []IPAllowListEntry `json:"ipAllowList"`
+	MaxmemoryPolicy string             `json:"maxmemoryPolicy,omitempty"`
+	Name            string             `json:"name,omitempty"`
+	OwnerID         string             `json:"ownerId,omitempty"`
+	Plan            string             `json:"plan,omitempty"`
+	Region          string             `json:"region,omitempty"`
+}
+
+type RedisConnectionInfo struct {
+	RedisCLICommand          string `json:"redisCLICommand"`
+	InternalConnectionString string `json:"internalConnectionString"`
+	ExternalConnectionString string `json:"externalConnectionString"`
+}
+
+type RedisInstances struct {
+	Redis `json:"redis"`
+}
+
+type GetRedisInstancesArgs struct {
+	Name    string
+	OwnerID string
+}
+
+func (c *Client) GetRedisInstances(args *GetRedisInstancesArgs) ([]Redis, error) {
+	var redisInstances []RedisInstances
+	parameters := url.Values{}
+	url, err := url.Parse(fmt.Sprintf("%s/%s", c.HostURL, redisPath))
+	if err != nil {
+		return nil, err
+	}
+	if args != nil {
+		if args.Name != "" {
+			parameters.Add("name", args.Name)
+		}
+		if args.OwnerID != "" {
+			parameters.Add("ownerId", args.OwnerID)
+		}
+	}
+	url.RawQuery = parameters.Encode()
+
+	err = c.doRequest(http.MethodGet, url.String(), nil, &redisInstances)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []Redis
+	for _, redis := range redisInstances {
+		result = append(result, redis.Redis)
+	}
+	return result, nil
+}
+
+func (c *Client) GetRedis(id string) (*Redis, error) {
+	redis := Redis{}
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("%s/%s/%s", c.HostURL, redisPath, id), nil, &redis)
+	if err != nil {
+		return nil, err
+	}
+
+	return &redis, nil
+}
+
+func (c *Client) GetRedisConnectionInfo(id string) (*RedisConnectionInfo, error) {
+	connectionInfo := RedisConnectionInfo{}
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("%s/%s/%s/connection-info", c.HostURL, redisPath, id), nil, &connectionInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &connectionInfo, nil
+}
+
+func (c *Client) CreateRedis(data RedisData) (*Redis, error) {
+	redis := Redis{}
+
+	redisInstances, err := c.GetRedisInstances(&GetRedisInstancesArgs{Name: data.Name, OwnerID: data.OwnerID})
+	if err != nil {
+		return nil, err
+	}
+	if redisInstances != nil {
+		return nil, fmt.Errorf("the name `%s` is already in use. Please use a different name", data.Name)
+	}
+
+	err = c.doRequest(http.MethodPost, fmt.Sprintf("%s/%s", c.HostURL, redisPath), data, &redis)
+	if err != nil {
+		return nil, err
+	}
+
+	return &redis, nil
+}
+
+func (c *Client) UpdateRedis(id string, data RedisData) (*Redis, error) {
+	redis := Redis{}
+
+	// The owner and region of an existing instance can't be changed.
+	patch := RedisData{
+		IPAllowList:     data.IPAllowList,
+		MaxmemoryPolicy: data.MaxmemoryPolicy,
+		Name:            data.Name,
+		Plan:            data.Plan,
+	}
+
+	err := c.doRequest(http.MethodPatch, fmt.Sprintf("%s/%s/%s", c.HostURL, redisPath, id), patch, &redis)
+	if err != nil {
+		return nil, err
+	}
+
+	return &redis, nil
+}
+
+func (c *Client) DeleteRedis(id string) error {
+	return c.doRequest(http.MethodDelete, fmt.Sprintf("%s/%s/%s", c.HostURL, redisPath, id), nil, nil)
+}
@@ -0,0 +1,55 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/sonlir/render-client-go"
+)
+
+const registryCredentialsPath = "registrycredentials"
+
+// CreateRegistryCredential replaces render.Client.CreateRegistryCredential,
+// whose check for a duplicate name treats an empty list as a match.
+func (c *Client) CreateRegistryCredential(data render.RegistryCredential) (*render.RegistryCredential, error) {
+	if err := c.checkRegistryCredentialName("", data.Name); err != nil {
+		return nil, err
+	}
+
+	var registryCredential *render.RegistryCredential
+	err := c.doRequest(http.MethodPost, fmt.Sprintf("%s/%s", c.HostURL, registryCredentialsPath), data, &registryCredential)
+	if err != nil {
+		return nil, err
+	}
+	return registryCredential, nil
+}
+
+// UpdateRegistryCredential replaces render.Client.UpdateRegistryCredential,
+// which panics when no other credential has the same name.
+func (c *Client) UpdateRegistryCredential(id string, data render.RegistryCredential) (*render.RegistryCredential, error) {
+	if err := c.checkRegistryCredentialName(id, data.Name); err != nil {
+		return nil, err
+	}
+
+	var registryCredential *render.RegistryCredential
+	err := c.doRequest(http.MethodPatch, fmt.Sprintf("%s/%s/%s", c.HostURL, registryCredentialsPath, id), data, &registryCredential)
+	if err != nil {
+		return nil, err
+	}
+	return registryCredential, nil
+}
+
+// checkRegistryCredentialName returns an error if a registry credential
+// other than id already uses name.
+func (c *Client) checkRegistryCredentialName(id, name string) error {
+	registryCredentials, err := c.GetRegistryCredentials(&render.GetRegistryCredentialsArgs{Name: name})
+	if err != nil {
+		return err
+	}
+	for _, registryCredential := range registryCredentials {
+		if registryCredential.Name == name && registryCredential.ID != id {
+			return fmt.Errorf("the name `%s` is already in use. Please use a different name", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,277 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/sonlir/render-client-go"
+)
+
+// servicesPageLimit is the largest page the services endpoint returns.
+const servicesPageLimit = 100
+
+type ServicesPage struct {
+	Cursor         string `json:"cursor"`
+	render.Service `json:"service"`
+}
+
+// ServiceData is the request body used to create or update a service. Its
+// environment variables replace the ones of render.Service, so that Render can
+// generate their values. UpdateService leaves the environment variables alone
+// if EnvVars is nil.
+type ServiceData struct {
+	render.Service
+	EnvVars []EnvVarInput `json:"envVars,omitempty"`
+}
+
+type ServiceSecretFiles struct {
+	SecretFile `json:"secretFile"`
+}
+
+type ListServicesArgs struct {
+	Name    string
+	OwnerID string
+	Type    string
+}
+
+// ListServices returns every service matching args. Unlike
+// render.Client.GetServices it follows the pagination cursor, and it does not
+// fetch the environment variables of each service.
+func (c *Client) ListServices(args *ListServicesArgs) ([]render.Service, error) {
+	var result []render.Service
+	cursor := ""
+	for {
+		parameters := url.Values{}
+		url, err := url.Parse(fmt.Sprintf("%s/%s", c.HostURL, servicesPath))
+		if err != nil {
+			return nil, err
+		}
+		if args != nil {
+			if args.Name != "" {
+				parameters.Add("name", args.Name)
+			}
+			if args.OwnerID != "" {
+				parameters.Add("ownerId", args.OwnerID)
+			}
+			if args.Type != "" {
+				parameters.Add("type", args.Type)
+			}
+		}
+		parameters.Add("limit", fmt.Sprint(servicesPageLimit))
+		if cursor != "" {
+			parameters.Add("cursor", cursor)
+		}
+		url.RawQuery = parameters.Encode()
+
+		var page []ServicesPage
+		err = c.doRequest(http.MethodGet, url.String(), nil, &page)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, service := range page {
+			result = append(result, service.Service)
+		}
+		if len(page) < servicesPageLimit {
+			return result, nil
+		}
+		cursor = page[len(page)-1].Cursor
+	}
+}
+
+// GetServiceSecretFiles returns the secret files of a service, which are not
+// part of the service itself.
+func (c *Client) GetServiceSecretFiles(serviceId string) ([]render.SecretFiles, error) {
+	var secretFiles []ServiceSecretFiles
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("%s/%s/%s/%s", c.HostURL, servicesPath, serviceId, secretFilesPath), nil, &secretFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []render.SecretFiles{}
+	for _, secretFile := range secretFiles {
+		result = append(result, render.SecretFiles{
+			Name:     secretFile.Name,
+			Contents: secretFile.Content,
+		})
+	}
+	return result, nil
+}
+
+// GetServiceSecretFile returns a single secret file of a service.
+func (c *Client) GetServiceSecretFile(serviceId, name string) (*SecretFile, error) {
+	secretFile := SecretFile{}
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("%s/%s/%s/%s/%s", c.HostURL, servicesPath, serviceId, secretFilesPath, url.PathEscape(name)), nil, &secretFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &secretFile, nil
+}
+
+// UpdateServiceSecretFile creates or updates a single secret file of a
+// service, leaving the other files alone.
+func (c *Client) UpdateServiceSecretFile(serviceId, name, content string) (*SecretFile, error) {
+	secretFile := SecretFile{}
+	err := c.doRequest(http.MethodPut, fmt.Sprintf("%s/%s/%s/%s/%s", c.HostURL, servicesPath, serviceId, secretFilesPath, url.PathEscape(name)), map[string]string{"content": content}, &secretFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &secretFile, nil
+}
+
+func (c *Client) DeleteServiceSecretFile(serviceId, name string) error {
+	return c.doRequest(http.MethodDelete, fmt.Sprintf("%s/%s/%s/%s/%s", c.HostURL, servicesPath, serviceId, secretFilesPath, url.PathEscape(name)), nil, nil)
+}
+
+// updateSecretFiles makes the secret files of a service match data, removing
+// the ones that are no longer present, unless data is nil. Render ignores the
+// secret files sent when patching a service, so they are set one by one.
+func (c *Client) updateSecretFiles(serviceId string, data []render.SecretFiles) error {
+	if data == nil {
+		return nil
+	}
+
+	current, err := c.GetServiceSecretFiles(serviceId)
+	if err != nil {
+		return err
+	}
+
+	names := map[string]bool{}
+	for _, secretFile := range data {
+		names[secretFile.Name] = true
+		_, err = c.UpdateServiceSecretFile(serviceId, secretFile.Name, secretFile.Contents)
+		if err != nil {
+			return err
+		}
+	}
+	for _, secretFile := range current {
+		if !names[secretFile.Name] {
+			err = c.DeleteServiceSecretFile(serviceId, secretFile.Name)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// CreateService creates a service like render.Client.CreateService, but sends
+// the environment variables of data as they are.
+func (c *Client) CreateService(data ServiceData) (*render.Service, error) {
+	service := render.Service{}
+
+	services, err := c.GetServices(&render.GetServicesArgs{Name: data.Name})
+	if err != nil {
+		return nil, err
+	}
+	if services != nil {
+		return nil, fmt.Errorf("the name `%s` is already in use. Please use a different name", data.Name)
+	}
+
+	err = c.doRequest(http.MethodPost, fmt.Sprintf("%s/%s", c.HostURL, servicesPath), data, &service)
+	if err != nil {
+		return nil, err
+	}
+
+	if isScalable(data.Type) && data.ServiceDetails.Autoscaling != nil {
+		autoscaling := render.Autoscaling{}
+		err = c.doRequest(http.MethodPut, fmt.Sprintf("%s/%s/%s/autoscaling", c.HostURL, servicesPath, service.ID), data.ServiceDetails.Autoscaling, &autoscaling)
+		if err != nil {
+			return nil, err
+		}
+		service.ServiceDetails.Autoscaling = &autoscaling
+	}
+
+	return &service, nil
+}
+
+// UpdateService updates a service like render.Client.UpdateService, but
+// replaces its environment variables with UpdateServiceEnvVars and its secret
+// files with updateSecretFiles, and only if data has any. The service itself
+// is patched first, and an error names the steps that were already applied.
+func (c *Client) UpdateService(id string, data ServiceData) (*render.Service, error) {
+	service := render.Service{}
+
+	services, err := c.GetServices(&render.GetServicesArgs{Name: data.Name})
+	if err != nil {
+		return nil, err
+	}
+	if services != nil && services[0].ID != id {
+		return nil, fmt.Errorf("the name `%s` is already in use. Please use a different name", data.Name)
+	}
+
+	err = c.doRequest(http.MethodPatch, fmt.Sprintf("%s/%s/%s", c.HostURL, servicesPath, id), data.Service, &service)
+	if err != nil {
+		return nil, err
+	}
+
+	steps := updateSteps{applied: []string{"service"}}
+	err = steps.run("environment variables", func() error {
+		envVars, err := c.updateEnvVars(id, data.EnvVars)
+		service.EnvVars = envVars
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = steps.run("secret files", func() error {
+		return c.updateSecretFiles(id, data.SecretFiles)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if isScalable(data.Type) {
+		if data.ServiceDetails.NumInstances != service.ServiceDetails.NumInstances {
+			err = steps.run("number of instances", func() error {
+				scale := render.Scale{NumInstances: data.ServiceDetails.NumInstances}
+				return c.doRequest(http.MethodPost, fmt.Sprintf("%s/%s/%s/scale", c.HostURL, servicesPath, id), scale, nil)
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+		if data.ServiceDetails.Autoscaling != nil {
+			err = steps.run("autoscaling", func() error {
+				autoscaling := render.Autoscaling{}
+				err := c.doRequest(http.MethodPut, fmt.Sprintf("%s/%s/%s/autoscaling", c.HostURL, servicesPath, id), data.ServiceDetails.Autoscaling, &autoscaling)
+				if err != nil {
+					return err
+				}
+				service.ServiceDetails.Autoscaling = &autoscaling
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return &service, nil
+}
+
+// updateSteps runs the steps of an update that follow the patch of the
+// service, so that an error tells which parts of the service were already
+// updated.
+type updateSteps struct {
+	applied []string
+}
+
+func (s *updateSteps) run(name string, step func() error) error {
+	if err := step(); err != nil {
+		return fmt.Errorf("updated the %s, but updating the %s failed: %w", strings.Join(s.applied, ", "), name, err)
+	}
+	s.applied = append(s.applied, name)
+	return nil
+}
+
+// isScalable reports whether services of the given type can be scaled and
+// autoscaled.
+func isScalable(serviceType string) bool {
+	return serviceType == "web_service" || serviceType == "private_service" || serviceType == "background_worker"
+}
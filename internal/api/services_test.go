@@ -0,0 +1,51 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/sonlir/render-client-go"
+)
+
+func TestUpdateServiceOrder(t *testing.T) {
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/services":
+			w.Write([]byte(`[]`))
+		case r.Method == http.MethodPatch:
+			w.Write([]byte(`{"id":"srv-test","name":"my-app","type":"web_service"}`))
+		case strings.HasSuffix(r.URL.Path, "/env-vars"):
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"message":"invalid key"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	apiKey := "test"
+	client, err := render.NewClient(&apiKey, &server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = NewClient(client).UpdateService("srv-test", ServiceData{
+		Service: render.Service{Name: "my-app", Type: "web_service"},
+		EnvVars: []EnvVarInput{{Key: "1NVALID"}},
+	})
+	if err == nil {
+		t.Fatal("expected the update to fail")
+	}
+	if !strings.Contains(err.Error(), "updated the service, but updating the environment variables failed") {
+		t.Errorf("expected the error to tell what was updated, got: %s", err)
+	}
+
+	want := []string{"GET /services", "PATCH /services/srv-test", "PUT /services/srv-test/env-vars"}
+	if strings.Join(calls, ", ") != strings.Join(want, ", ") {
+		t.Errorf("expected the calls %v, got %v", want, calls)
+	}
+}
@@ -0,0 +1,172 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/sonlir/render-client-go"
+)
+
+const (
+	servicesPath = "services"
+	headersPath  = "headers"
+	routesPath   = "routes"
+)
+
+// StaticSite is a render.Service whose service details carry the static site
+// specific fields. render.ServiceDetails sends the publish path as
+// `publicPath`, which the API ignores.
+type StaticSite struct {
+	render.Service
+	ServiceDetails StaticSiteDetails `json:"serviceDetails,omitempty"`
+}
+
+// StaticSiteData is the request body used to create or update a static site.
+// Like ServiceData, it can ask Render to generate environment variables, and
+// UpdateStaticSite leaves them, the headers and the routes alone if they are nil.
+type StaticSiteData struct {
+	StaticSite
+	EnvVars []EnvVarInput `json:"envVars,omitempty"`
+}
+
+type StaticSiteDetails struct {
+	BuildCommand               string               `json:"buildCommand,omitempty"`
+	Headers                    []render.Header      `json:"headers,omitempty"`
+	ParentServer               *render.ParentServer `json:"parentServer,omitempty"`
+	PublishPath                string               `json:"publishPath,omitempty"`
+	PullRequestPreviewsEnabled string               `json:"pullRequestPreviewsEnabled,omitempty"`
+	Routes                     []render.Route       `json:"routes,omitempty"`
+	URL                        string               `json:"url,omitempty"`
+}
+
+type Headers struct {
+	render.Header `json:"header"`
+}
+
+type Routes struct {
+	render.Route `json:"route"`
+}
+
+func (c *Client) GetStaticSite(id string) (*StaticSite, error) {
+	staticSite := StaticSite{}
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("%s/%s/%s", c.HostURL, servicesPath, id), nil, &staticSite)
+	if err != nil {
+		return nil, err
+	}
+
+	staticSite.EnvVars, err = c.GetEnvironmentVariables(id)
+	if err != nil {
+		return nil, err
+	}
+
+	staticSite.ServiceDetails.Headers, err = c.GetHeaders(id)
+	if err != nil {
+		return nil, err
+	}
+
+	staticSite.ServiceDetails.Routes, err = c.GetRoutes(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &staticSite, nil
+}
+
+func (c *Client) CreateStaticSite(data StaticSiteData) (*StaticSite, error) {
+	staticSite := StaticSite{}
+
+	services, err := c.GetServices(&render.GetServicesArgs{Name: data.Name})
+	if err != nil {
+		return nil, err
+	}
+	if services != nil {
+		return nil, fmt.Errorf("the name `%s` is already in use. Please use a different name", data.Name)
+	}
+
+	err = c.doRequest(http.MethodPost, fmt.Sprintf("%s/%s", c.HostURL, servicesPath), data, &staticSite)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetStaticSite(staticSite.ID)
+}
+
+func (c *Client) UpdateStaticSite(id string, data StaticSiteData) (*StaticSite, error) {
+	services, err := c.GetServices(&render.GetServicesArgs{Name: data.Name})
+	if err != nil {
+		return nil, err
+	}
+	if services != nil && services[0].ID != id {
+		return nil, fmt.Errorf("the name `%s` is already in use. Please use a different name", data.Name)
+	}
+
+	// Headers and routes can't be patched on the service, they are replaced
+	// through their own endpoints below, and only if data has any.
+	patch := data.StaticSite
+	patch.ServiceDetails.Headers = nil
+	patch.ServiceDetails.Routes = nil
+
+	err = c.doRequest(http.MethodPatch, fmt.Sprintf("%s/%s/%s", c.HostURL, servicesPath, id), patch, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	steps := updateSteps{applied: []string{"static site"}}
+	if data.EnvVars != nil {
+		err = steps.run("environment variables", func() error {
+			_, err := c.UpdateServiceEnvVars(id, data.EnvVars)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if data.ServiceDetails.Headers != nil {
+		err = steps.run("headers", func() error {
+			return c.doRequest(http.MethodPut, fmt.Sprintf("%s/%s/%s/%s", c.HostURL, servicesPath, id, headersPath), data.ServiceDetails.Headers, nil)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if data.ServiceDetails.Routes != nil {
+		err = steps.run("routes", func() error {
+			return c.doRequest(http.MethodPut, fmt.Sprintf("%s/%s/%s/%s", c.HostURL, servicesPath, id, routesPath), data.ServiceDetails.Routes, nil)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return c.GetStaticSite(id)
+}
+
+func (c *Client) GetHeaders(serviceId string) ([]render.Header, error) {
+	headers := []Headers{}
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("%s/%s/%s/%s", c.HostURL, servicesPath, serviceId, headersPath), nil, &headers)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []render.Header{}
+	for _, header := range headers {
+		result = append(result, header.Header)
+	}
+	return result, nil
+}
+
+func (c *Client) GetRoutes(serviceId string) ([]render.Route, error) {
+	routes := []Routes{}
+	err := c.doRequest(http.MethodGet, fmt.Sprintf("%s/%s/%s/%s", c.HostURL, servicesPath, serviceId, routesPath), nil, &routes)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []render.Route{}
+	for _, route := range routes {
+		result = append(result, route.Route)
+	}
+	return result, nil
+}
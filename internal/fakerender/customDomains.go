@@ -0,0 +1,170 @@
+package fakerender
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/sonlir/render-client-go"
+)
+
+// VerifyCustomDomain marks a custom domain as verified without going through
+// the API, as if its DNS records had been created.
+func (s *Server) VerifyCustomDomain(serviceID, idOrName string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	svc, ok := s.services[serviceID]
+	if !ok {
+		panic("fakerender: unknown service " + serviceID)
+	}
+	customDomain := svc.customDomain(idOrName)
+	if customDomain == nil {
+		panic("fakerender: unknown custom domain " + idOrName)
+	}
+	customDomain.VerificationStatus = stringPointer("verified")
+}
+
+func (s *Server) listCustomDomains(w http.ResponseWriter, r *http.Request) {
+	type customDomainItem struct {
+		CustomDomain render.CustomDomain `json:"customDomain"`
+		Cursor       string              `json:"cursor"`
+	}
+	svc, ok := s.services[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "service not found")
+		return
+	}
+	items := []customDomainItem{}
+	for _, customDomain := range svc.customDomains {
+		items = append(items, customDomainItem{CustomDomain: customDomain, Cursor: *customDomain.ID})
+	}
+	writeJSON(w, http.StatusOK, items)
+}
+
+// createCustomDomain adds the domain, and like Render the www subdomain of an
+// apex domain or the apex domain of a www subdomain as a redirect to it.
+func (s *Server) createCustomDomain(w http.ResponseWriter, r *http.Request) {
+	svc, ok := s.services[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "service not found")
+		return
+	}
+	if svc.Type != "web_service" && svc.Type != "static_site" {
+		writeError(w, http.StatusBadRequest, "custom domains can only be added to web services and static sites")
+		return
+	}
+	var data render.CustomDomain
+	if !readJSON(w, r, &data) {
+		return
+	}
+	name := strings.ToLower(data.Name)
+	if strings.Count(name, ".") < 1 || strings.HasPrefix(name, ".") || strings.HasSuffix(name, ".") {
+		writeError(w, http.StatusBadRequest, "invalid domain name: "+data.Name)
+		return
+	}
+	for _, other := range s.services {
+		if other.customDomain(name) != nil {
+			writeError(w, http.StatusConflict, "custom domain already exists: "+name)
+			return
+		}
+	}
+
+	created := []render.CustomDomain{s.newCustomDomain(svc, name, nil)}
+	switch {
+	case strings.Count(name, ".") == 1:
+		created = append(created, s.newCustomDomain(svc, "www."+name, &name))
+	case strings.HasPrefix(name, "www.") && strings.Count(name, ".") == 2:
+		created = append(created, s.newCustomDomain(svc, strings.TrimPrefix(name, "www."), &name))
+	}
+	svc.customDomains = append(svc.customDomains, created...)
+	writeJSON(w, http.StatusCreated, created)
+}
+
+func (s *Server) newCustomDomain(svc *service, name string, redirectForName *string) render.CustomDomain {
+	domainType := "subdomain"
+	if strings.Count(name, ".") == 1 {
+		domainType = "apex"
+	}
+	return render.CustomDomain{
+		ID:                 stringPointer(s.newID("cdm")),
+		Name:               name,
+		DomainType:         &domainType,
+		PublicSuffix:       stringPointer(name[strings.LastIndex(name, ".")+1:]),
+		RedirectForName:    redirectForName,
+		VerificationStatus: stringPointer("unverified"),
+		CreatedAt:          stringPointer(time.Now().UTC().Format(time.RFC3339)),
+		Server:             &render.Server{ID: stringPointer(svc.ID), Name: stringPointer(svc.Name)},
+	}
+}
+
+func (s *Server) getCustomDomain(w http.ResponseWriter, r *http.Request) {
+	svc, ok := s.services[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "service not found")
+		return
+	}
+	customDomain := svc.customDomain(r.PathValue("idOrName"))
+	if customDomain == nil {
+		writeError(w, http.StatusNotFound, "custom domain not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, customDomain)
+}
+
+// deleteCustomDomain deletes the domain along with the domains redirecting to
+// it.
+func (s *Server) deleteCustomDomain(w http.ResponseWriter, r *http.Request) {
+	svc, ok := s.services[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "service not found")
+		return
+	}
+	customDomain := svc.customDomain(r.PathValue("idOrName"))
+	if customDomain == nil {
+		writeError(w, http.StatusNotFound, "custom domain not found")
+		return
+	}
+	name := customDomain.Name
+	customDomains := []render.CustomDomain{}
+	for _, other := range svc.customDomains {
+		if other.Name == name || (other.RedirectForName != nil && *other.RedirectForName == name) {
+			continue
+		}
+		customDomains = append(customDomains, other)
+	}
+	svc.customDomains = customDomains
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// verifyCustomDomain fails like Render does while the DNS records are
+// missing. Tests call Server.VerifyCustomDomain to verify a domain.
+func (s *Server) verifyCustomDomain(w http.ResponseWriter, r *http.Request) {
+	svc, ok := s.services[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "service not found")
+		return
+	}
+	customDomain := svc.customDomain(r.PathValue("idOrName"))
+	if customDomain == nil {
+		writeError(w, http.StatusNotFound, "custom domain not found")
+		return
+	}
+	if *customDomain.VerificationStatus != "verified" {
+		writeError(w, http.StatusBadRequest, "the DNS records of "+customDomain.Name+" could not be verified")
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (svc *service) customDomain(idOrName string) *render.CustomDomain {
+	for i := range svc.customDomains {
+		if *svc.customDomains[i].ID == idOrName || svc.customDomains[i].Name == idOrName {
+			return &svc.customDomains[i]
+		}
+	}
+	return nil
+}
+
+func stringPointer(value string) *string {
+	return &value
+}
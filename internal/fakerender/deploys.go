@@ -0,0 +1,158 @@
+package fakerender
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Deploy is a deploy of a service. Deploys finish as soon as they are
+// created, with the status given to SetDeployStatus.
+type Deploy struct {
+	ID         string        `json:"id"`
+	Commit     *DeployCommit `json:"commit,omitempty"`
+	CreatedAt  string        `json:"createdAt"`
+	FinishedAt string        `json:"finishedAt,omitempty"`
+	Image      *DeployImage  `json:"image,omitempty"`
+	Status     string        `json:"status"`
+	Trigger    string        `json:"trigger"`
+	UpdatedAt  string        `json:"updatedAt"`
+}
+
+type DeployCommit struct {
+	ID string `json:"id"`
+}
+
+type DeployImage struct {
+	Ref string `json:"ref"`
+}
+
+// deployInput is the body of a request to trigger a deploy.
+type deployInput struct {
+	ClearCache string `json:"clearCache"`
+	CommitID   string `json:"commitId"`
+	ImageURL   string `json:"imageUrl"`
+}
+
+// Deploys returns the deploys of a service, oldest first.
+func (s *Server) Deploys(serviceID string) []Deploy {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	svc, ok := s.services[serviceID]
+	if !ok {
+		return nil
+	}
+	return append([]Deploy{}, svc.deploys...)
+}
+
+// SetDeployStatus sets the status new deploys finish with, `live` by
+// default.
+func (s *Server) SetDeployStatus(status string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.deployStatus = status
+}
+
+// newDeploy returns a finished deploy of svc. The caller adds it to the
+// service.
+func (s *Server) newDeploy(svc *service, input deployInput) Deploy {
+	now := time.Now().UTC().Format(time.RFC3339)
+	status := s.deployStatus
+	if status == "" {
+		status = "live"
+	}
+	if status == "live" {
+		for i := range svc.deploys {
+			if svc.deploys[i].Status == "live" {
+				svc.deploys[i].Status = "deactivated"
+			}
+		}
+	}
+
+	d := Deploy{
+		ID:         s.newID("dep"),
+		CreatedAt:  now,
+		FinishedAt: now,
+		Status:     status,
+		Trigger:    "api",
+		UpdatedAt:  now,
+	}
+	switch {
+	case input.ImageURL != "":
+		d.Image = &DeployImage{Ref: input.ImageURL}
+	case svc.Image != nil:
+		d.Image = &DeployImage{Ref: svc.Image.ImagePath}
+	case input.CommitID != "":
+		d.Commit = &DeployCommit{ID: input.CommitID}
+	default:
+		d.Commit = &DeployCommit{ID: fmt.Sprintf("%040d", s.nextID)}
+	}
+	return d
+}
+
+func (s *Server) listDeploys(w http.ResponseWriter, r *http.Request) {
+	type deployItem struct {
+		Deploy Deploy `json:"deploy"`
+		Cursor string `json:"cursor"`
+	}
+	svc, ok := s.services[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "service not found")
+		return
+	}
+	limit := defaultLimit
+	if v := r.URL.Query().Get("limit"); v != "" {
+		var err error
+		limit, err = strconv.Atoi(v)
+		if err != nil || limit < 1 || limit > 100 {
+			writeError(w, http.StatusBadRequest, "limit must be between 1 and 100")
+			return
+		}
+	}
+
+	items := []deployItem{}
+	for i := len(svc.deploys) - 1; i >= 0 && len(items) < limit; i-- {
+		items = append(items, deployItem{Deploy: svc.deploys[i], Cursor: svc.deploys[i].ID})
+	}
+	writeJSON(w, http.StatusOK, items)
+}
+
+func (s *Server) createDeploy(w http.ResponseWriter, r *http.Request) {
+	svc, ok := s.services[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "service not found")
+		return
+	}
+	var input deployInput
+	if !readJSON(w, r, &input) {
+		return
+	}
+	if input.ClearCache != "" && input.ClearCache != "clear" && input.ClearCache != "do_not_clear" {
+		writeError(w, http.StatusBadRequest, "clearCache must be clear or do_not_clear")
+		return
+	}
+	if input.ImageURL != "" && svc.Image == nil {
+		writeError(w, http.StatusBadRequest, "imageUrl can only be set for services deployed from an image")
+		return
+	}
+
+	d := s.newDeploy(svc, input)
+	svc.deploys = append(svc.deploys, d)
+	writeJSON(w, http.StatusCreated, d)
+}
+
+func (s *Server) getDeploy(w http.ResponseWriter, r *http.Request) {
+	svc, ok := s.services[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "service not found")
+		return
+	}
+	for _, d := range svc.deploys {
+		if d.ID == r.PathValue("deployId") {
+			writeJSON(w, http.StatusOK, d)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "deploy not found")
+}
@@ -0,0 +1,191 @@
+package fakerender
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/sonlir/render-client-go"
+)
+
+type disk struct {
+	ID        string `json:"id"`
+	CreatedAt string `json:"createdAt"`
+	MountPath string `json:"mountPath"`
+	Name      string `json:"name"`
+	ServiceID string `json:"serviceId"`
+	SizeGB    int64  `json:"sizeGB"`
+	UpdatedAt string `json:"updatedAt"`
+
+	snapshots []diskSnapshot
+}
+
+type diskSnapshot struct {
+	CreatedAt   string `json:"createdAt"`
+	SnapshotKey string `json:"snapshotKey"`
+}
+
+// diskInput is the body of a request to create or update a disk.
+type diskInput struct {
+	MountPath string `json:"mountPath"`
+	Name      string `json:"name"`
+	ServiceID string `json:"serviceId"`
+	SizeGB    int64  `json:"sizeGB"`
+}
+
+// DiskIDs returns the IDs of every disk.
+func (s *Server) DiskIDs() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return sortedKeys(s.disks)
+}
+
+// AddDiskSnapshot adds a snapshot to a disk, which Render takes once a day,
+// and returns its key.
+func (s *Server) AddDiskSnapshot(diskID string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	d, ok := s.disks[diskID]
+	if !ok {
+		panic("fakerender: unknown disk " + diskID)
+	}
+	snapshot := diskSnapshot{
+		CreatedAt:   time.Now().UTC().Format(time.RFC3339),
+		SnapshotKey: s.newID("snapshot"),
+	}
+	d.snapshots = append(d.snapshots, snapshot)
+	return snapshot.SnapshotKey
+}
+
+// addDisk creates a disk for the service, which must not have one yet.
+func (s *Server) addDisk(serviceID string, data render.Disk) *disk {
+	now := time.Now().UTC().Format(time.RFC3339)
+	d := &disk{
+		ID:        s.newID("dsk"),
+		CreatedAt: now,
+		MountPath: data.MountPath,
+		Name:      data.Name,
+		ServiceID: serviceID,
+		SizeGB:    data.SizeGB,
+		UpdatedAt: now,
+		snapshots: []diskSnapshot{},
+	}
+	if d.SizeGB == 0 {
+		d.SizeGB = 1
+	}
+	s.disks[d.ID] = d
+	return d
+}
+
+func (s *Server) listDisks(w http.ResponseWriter, r *http.Request) {
+	type diskItem struct {
+		Disk   *disk  `json:"disk"`
+		Cursor string `json:"cursor"`
+	}
+	query := r.URL.Query()
+	items := []diskItem{}
+	for _, id := range sortedKeys(s.disks) {
+		d := s.disks[id]
+		if name := query.Get("name"); name != "" && d.Name != name {
+			continue
+		}
+		if serviceID := query.Get("serviceId"); serviceID != "" && d.ServiceID != serviceID {
+			continue
+		}
+		if ownerID := query.Get("ownerId"); ownerID != "" {
+			if svc, ok := s.services[d.ServiceID]; !ok || svc.OwnerID != ownerID {
+				continue
+			}
+		}
+		items = append(items, diskItem{Disk: d, Cursor: id})
+	}
+	writeJSON(w, http.StatusOK, items)
+}
+
+func (s *Server) createDisk(w http.ResponseWriter, r *http.Request) {
+	var input diskInput
+	if !readJSON(w, r, &input) {
+		return
+	}
+	if input.Name == "" || input.MountPath == "" || input.ServiceID == "" {
+		writeError(w, http.StatusBadRequest, "name, mountPath and serviceId are required")
+		return
+	}
+	svc, ok := s.services[input.ServiceID]
+	if !ok {
+		writeError(w, http.StatusBadRequest, "service not found: "+input.ServiceID)
+		return
+	}
+	if svc.ServiceDetails.Disk != nil {
+		writeError(w, http.StatusConflict, "the service already has a disk")
+		return
+	}
+
+	d := s.addDisk(svc.ID, render.Disk{Name: input.Name, MountPath: input.MountPath, SizeGB: input.SizeGB})
+	svc.ServiceDetails.Disk = &render.Disk{Id: d.ID, Name: d.Name}
+	writeJSON(w, http.StatusCreated, d)
+}
+
+func (s *Server) getDisk(w http.ResponseWriter, r *http.Request) {
+	d, ok := s.disks[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "disk not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, d)
+}
+
+func (s *Server) updateDisk(w http.ResponseWriter, r *http.Request) {
+	d, ok := s.disks[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "disk not found")
+		return
+	}
+	var input diskInput
+	if !readJSON(w, r, &input) {
+		return
+	}
+	if input.ServiceID != "" && input.ServiceID != d.ServiceID {
+		writeError(w, http.StatusBadRequest, "the service of a disk can't be changed")
+		return
+	}
+	if input.SizeGB != 0 && input.SizeGB < d.SizeGB {
+		writeError(w, http.StatusBadRequest, "the size of a disk can't be decreased")
+		return
+	}
+	setString(&d.Name, input.Name)
+	setString(&d.MountPath, input.MountPath)
+	if input.SizeGB != 0 {
+		d.SizeGB = input.SizeGB
+	}
+	d.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+	if svc, ok := s.services[d.ServiceID]; ok {
+		svc.ServiceDetails.Disk = &render.Disk{Id: d.ID, Name: d.Name}
+	}
+	writeJSON(w, http.StatusOK, d)
+}
+
+func (s *Server) deleteDisk(w http.ResponseWriter, r *http.Request) {
+	d, ok := s.disks[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "disk not found")
+		return
+	}
+	if svc, ok := s.services[d.ServiceID]; ok {
+		svc.ServiceDetails.Disk = nil
+	}
+	delete(s.disks, d.ID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) getDiskSnapshots(w http.ResponseWriter, r *http.Request) {
+	d, ok := s.disks[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "disk not found")
+		return
+	}
+	snapshots := []diskSnapshot{}
+	for i := len(d.snapshots) - 1; i >= 0; i-- {
+		snapshots = append(snapshots, d.snapshots[i])
+	}
+	writeJSON(w, http.StatusOK, snapshots)
+}
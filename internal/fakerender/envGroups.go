@@ -0,0 +1,260 @@
+package fakerender
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/sonlir/render-client-go"
+)
+
+type envGroup struct {
+	ID           string                       `json:"id"`
+	CreatedAt    string                       `json:"createdAt"`
+	EnvVars      []render.EnvironmentVariable `json:"envVars"`
+	Name         string                       `json:"name"`
+	OwnerID      string                       `json:"ownerId"`
+	SecretFiles  []secretFile                 `json:"secretFiles"`
+	ServiceLinks []serviceLink                `json:"serviceLinks"`
+	UpdatedAt    string                       `json:"updatedAt"`
+}
+
+type serviceLink struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// EnvGroupIDs returns the IDs of every environment group.
+func (s *Server) EnvGroupIDs() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return sortedKeys(s.envGroups)
+}
+
+// EnvGroupServiceIDs returns the IDs of the services linked to an
+// environment group.
+func (s *Server) EnvGroupServiceIDs(id string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	group, ok := s.envGroups[id]
+	if !ok {
+		return nil
+	}
+	ids := []string{}
+	for _, link := range group.ServiceLinks {
+		ids = append(ids, link.ID)
+	}
+	return ids
+}
+
+func (group *envGroup) unlink(serviceID string) bool {
+	for i, link := range group.ServiceLinks {
+		if link.ID == serviceID {
+			group.ServiceLinks = append(group.ServiceLinks[:i], group.ServiceLinks[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) listEnvGroups(w http.ResponseWriter, r *http.Request) {
+	type envGroupItem struct {
+		EnvGroup *envGroup `json:"envGroup"`
+		Cursor   string    `json:"cursor"`
+	}
+	items := []envGroupItem{}
+	for _, id := range sortedKeys(s.envGroups) {
+		group := s.envGroups[id]
+		if name := r.URL.Query().Get("name"); name != "" && group.Name != name {
+			continue
+		}
+		if ownerID := r.URL.Query().Get("ownerId"); ownerID != "" && group.OwnerID != ownerID {
+			continue
+		}
+		items = append(items, envGroupItem{EnvGroup: group, Cursor: id})
+	}
+	writeJSON(w, http.StatusOK, items)
+}
+
+func (s *Server) createEnvGroup(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		EnvVars     []envVarInput `json:"envVars"`
+		Name        string        `json:"name"`
+		OwnerID     string        `json:"ownerId"`
+		SecretFiles []secretFile  `json:"secretFiles"`
+	}
+	if !readJSON(w, r, &request) {
+		return
+	}
+	if request.Name == "" || request.OwnerID == "" {
+		writeError(w, http.StatusBadRequest, "name and ownerId are required")
+		return
+	}
+	envVars, ok := s.makeEnvVars(w, request.EnvVars)
+	if !ok {
+		return
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	group := &envGroup{
+		ID:           s.newID("evg"),
+		CreatedAt:    now,
+		EnvVars:      envVars,
+		Name:         request.Name,
+		OwnerID:      request.OwnerID,
+		SecretFiles:  []secretFile{},
+		ServiceLinks: []serviceLink{},
+		UpdatedAt:    now,
+	}
+	if request.SecretFiles != nil {
+		group.SecretFiles = request.SecretFiles
+	}
+	s.envGroups[group.ID] = group
+	writeJSON(w, http.StatusCreated, group)
+}
+
+func (s *Server) getEnvGroup(w http.ResponseWriter, r *http.Request) {
+	group, ok := s.envGroups[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "environment group not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, group)
+}
+
+func (s *Server) updateEnvGroup(w http.ResponseWriter, r *http.Request) {
+	group, ok := s.envGroups[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "environment group not found")
+		return
+	}
+	var data struct {
+		Name string `json:"name"`
+	}
+	if !readJSON(w, r, &data) {
+		return
+	}
+	setString(&group.Name, data.Name)
+	group.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+	writeJSON(w, http.StatusOK, group)
+}
+
+func (s *Server) deleteEnvGroup(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.envGroups[r.PathValue("id")]; !ok {
+		writeError(w, http.StatusNotFound, "environment group not found")
+		return
+	}
+	delete(s.envGroups, r.PathValue("id"))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) updateEnvGroupEnvVar(w http.ResponseWriter, r *http.Request) {
+	group, ok := s.envGroups[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "environment group not found")
+		return
+	}
+	var input envVarInput
+	if !readJSON(w, r, &input) {
+		return
+	}
+	input.Key = r.PathValue("key")
+	envVars, ok := s.makeEnvVars(w, []envVarInput{input})
+	if !ok {
+		return
+	}
+	group.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+	for i := range group.EnvVars {
+		if group.EnvVars[i].Key == input.Key {
+			group.EnvVars[i] = envVars[0]
+			writeJSON(w, http.StatusOK, envVars[0])
+			return
+		}
+	}
+	group.EnvVars = append(group.EnvVars, envVars[0])
+	writeJSON(w, http.StatusOK, envVars[0])
+}
+
+func (s *Server) deleteEnvGroupEnvVar(w http.ResponseWriter, r *http.Request) {
+	group, ok := s.envGroups[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "environment group not found")
+		return
+	}
+	for i := range group.EnvVars {
+		if group.EnvVars[i].Key == r.PathValue("key") {
+			group.EnvVars = append(group.EnvVars[:i], group.EnvVars[i+1:]...)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "environment variable not found")
+}
+
+func (s *Server) updateEnvGroupSecretFile(w http.ResponseWriter, r *http.Request) {
+	group, ok := s.envGroups[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "environment group not found")
+		return
+	}
+	var data secretFile
+	if !readJSON(w, r, &data) {
+		return
+	}
+	data.Name = r.PathValue("name")
+	group.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+	for i := range group.SecretFiles {
+		if group.SecretFiles[i].Name == data.Name {
+			group.SecretFiles[i] = data
+			writeJSON(w, http.StatusOK, data)
+			return
+		}
+	}
+	group.SecretFiles = append(group.SecretFiles, data)
+	writeJSON(w, http.StatusOK, data)
+}
+
+func (s *Server) deleteEnvGroupSecretFile(w http.ResponseWriter, r *http.Request) {
+	group, ok := s.envGroups[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "environment group not found")
+		return
+	}
+	for i := range group.SecretFiles {
+		if group.SecretFiles[i].Name == r.PathValue("name") {
+			group.SecretFiles = append(group.SecretFiles[:i], group.SecretFiles[i+1:]...)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "secret file not found")
+}
+
+func (s *Server) linkEnvGroupService(w http.ResponseWriter, r *http.Request) {
+	group, ok := s.envGroups[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "environment group not found")
+		return
+	}
+	svc, ok := s.services[r.PathValue("serviceId")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "service not found")
+		return
+	}
+	group.unlink(svc.ID)
+	group.ServiceLinks = append(group.ServiceLinks, serviceLink{ID: svc.ID, Name: svc.Name, Type: svc.Type})
+	writeJSON(w, http.StatusOK, group)
+}
+
+func (s *Server) unlinkEnvGroupService(w http.ResponseWriter, r *http.Request) {
+	group, ok := s.envGroups[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "environment group not found")
+		return
+	}
+	if !group.unlink(r.PathValue("serviceId")) {
+		writeError(w, http.StatusNotFound, "service is not linked to the environment group")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
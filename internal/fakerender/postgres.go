@@ -0,0 +1,230 @@
+package fakerender
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/sonlir/render-client-go"
+)
+
+type postgres struct {
+	ID                      string            `json:"id"`
+	CreatedAt               string            `json:"createdAt"`
+	DatabaseName            string            `json:"databaseName"`
+	DatabaseUser            string            `json:"databaseUser"`
+	HighAvailabilityEnabled bool              `json:"highAvailabilityEnabled"`
+	IPAllowList             []ipAllowEntry    `json:"ipAllowList"`
+	Name                    string            `json:"name"`
+	Owner                   render.Owner      `json:"owner"`
+	Plan                    string            `json:"plan"`
+	ReadReplicas            []postgresReplica `json:"readReplicas"`
+	Region                  string            `json:"region"`
+	Status                  string            `json:"status"`
+	UpdatedAt               string            `json:"updatedAt"`
+	Version                 string            `json:"version"`
+
+	password string
+}
+
+type ipAllowEntry struct {
+	CIDRBlock   string `json:"cidrBlock"`
+	Description string `json:"description"`
+}
+
+type postgresReplica struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// postgresInput is the body of a request to create or update a Postgres
+// instance.
+type postgresInput struct {
+	DatabaseName           string            `json:"databaseName"`
+	DatabaseUser           string            `json:"databaseUser"`
+	EnableHighAvailability *bool             `json:"enableHighAvailability"`
+	IPAllowList            []ipAllowEntry    `json:"ipAllowList"`
+	Name                   string            `json:"name"`
+	OwnerID                string            `json:"ownerId"`
+	Plan                   string            `json:"plan"`
+	ReadReplicas           []postgresReplica `json:"readReplicas"`
+	Region                 string            `json:"region"`
+	Version                string            `json:"version"`
+}
+
+// PostgresIDs returns the IDs of every Postgres instance.
+func (s *Server) PostgresIDs() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return sortedKeys(s.postgres)
+}
+
+func (s *Server) listPostgres(w http.ResponseWriter, r *http.Request) {
+	type postgresItem struct {
+		Postgres *postgres `json:"postgres"`
+		Cursor   string    `json:"cursor"`
+	}
+	items := []postgresItem{}
+	for _, id := range sortedKeys(s.postgres) {
+		instance := s.postgres[id]
+		if name := r.URL.Query().Get("name"); name != "" && instance.Name != name {
+			continue
+		}
+		if ownerID := r.URL.Query().Get("ownerId"); ownerID != "" && instance.Owner.ID != ownerID {
+			continue
+		}
+		items = append(items, postgresItem{Postgres: instance, Cursor: id})
+	}
+	writeJSON(w, http.StatusOK, items)
+}
+
+func (s *Server) createPostgres(w http.ResponseWriter, r *http.Request) {
+	var input postgresInput
+	if !readJSON(w, r, &input) {
+		return
+	}
+	if input.Name == "" || input.OwnerID == "" {
+		writeError(w, http.StatusBadRequest, "name and ownerId are required")
+		return
+	}
+	owner, ok := s.owners[input.OwnerID]
+	if !ok {
+		writeError(w, http.StatusBadRequest, "owner not found: "+input.OwnerID)
+		return
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	instance := &postgres{
+		ID:           s.newID("dpg"),
+		CreatedAt:    now,
+		DatabaseName: input.DatabaseName,
+		DatabaseUser: input.DatabaseUser,
+		IPAllowList:  []ipAllowEntry{},
+		Name:         input.Name,
+		Owner:        owner,
+		Plan:         input.Plan,
+		ReadReplicas: []postgresReplica{},
+		Region:       input.Region,
+		Status:       "available",
+		UpdatedAt:    now,
+		Version:      input.Version,
+		password:     s.newID("password"),
+	}
+	if instance.DatabaseName == "" {
+		instance.DatabaseName = strings.ReplaceAll(slug(input.Name), "-", "_")
+	}
+	if instance.DatabaseUser == "" {
+		instance.DatabaseUser = instance.DatabaseName + "_user"
+	}
+	if instance.Plan == "" {
+		instance.Plan = "starter"
+	}
+	if instance.Region == "" {
+		instance.Region = "oregon"
+	}
+	if instance.Version == "" {
+		instance.Version = "16"
+	}
+	if !s.updatePostgresInstance(w, instance, input) {
+		return
+	}
+	s.postgres[instance.ID] = instance
+	writeJSON(w, http.StatusCreated, instance)
+}
+
+func (s *Server) getPostgres(w http.ResponseWriter, r *http.Request) {
+	instance, ok := s.postgres[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "postgres instance not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, instance)
+}
+
+func (s *Server) updatePostgres(w http.ResponseWriter, r *http.Request) {
+	instance, ok := s.postgres[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "postgres instance not found")
+		return
+	}
+	var input postgresInput
+	if !readJSON(w, r, &input) {
+		return
+	}
+	if input.OwnerID != "" || input.Region != "" || input.DatabaseName != "" || input.DatabaseUser != "" {
+		writeError(w, http.StatusBadRequest, "ownerId, region, databaseName and databaseUser can't be changed")
+		return
+	}
+	updated := *instance
+	setString(&updated.Name, input.Name)
+	setString(&updated.Plan, input.Plan)
+	setString(&updated.Version, input.Version)
+	if !s.updatePostgresInstance(w, &updated, input) {
+		return
+	}
+	updated.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+	*instance = updated
+	writeJSON(w, http.StatusOK, instance)
+}
+
+// updatePostgresInstance applies the fields that can be set both on create
+// and on update.
+func (s *Server) updatePostgresInstance(w http.ResponseWriter, instance *postgres, input postgresInput) bool {
+	if input.EnableHighAvailability != nil {
+		if *input.EnableHighAvailability && !strings.HasPrefix(instance.Plan, "pro") && !strings.HasPrefix(instance.Plan, "accelerated") {
+			writeError(w, http.StatusBadRequest, "high availability requires a pro or accelerated plan")
+			return false
+		}
+		instance.HighAvailabilityEnabled = *input.EnableHighAvailability
+	}
+	if input.IPAllowList != nil {
+		instance.IPAllowList = input.IPAllowList
+	}
+	if input.ReadReplicas != nil {
+		replicas := []postgresReplica{}
+		for _, replica := range input.ReadReplicas {
+			if replica.Name == "" {
+				writeError(w, http.StatusBadRequest, "each read replica needs a name")
+				return false
+			}
+			replica.ID = ""
+			for _, existing := range instance.ReadReplicas {
+				if existing.Name == replica.Name {
+					replica.ID = existing.ID
+				}
+			}
+			if replica.ID == "" {
+				replica.ID = s.newID("dpg")
+			}
+			replicas = append(replicas, replica)
+		}
+		instance.ReadReplicas = replicas
+	}
+	return true
+}
+
+func (s *Server) deletePostgres(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.postgres[r.PathValue("id")]; !ok {
+		writeError(w, http.StatusNotFound, "postgres instance not found")
+		return
+	}
+	delete(s.postgres, r.PathValue("id"))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) getPostgresConnectionInfo(w http.ResponseWriter, r *http.Request) {
+	instance, ok := s.postgres[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "postgres instance not found")
+		return
+	}
+	credentials := instance.DatabaseUser + ":" + instance.password
+	externalHost := fmt.Sprintf("%s.%s-postgres.render.com", instance.ID, instance.Region)
+	writeJSON(w, http.StatusOK, map[string]string{
+		"password":                 instance.password,
+		"internalConnectionString": fmt.Sprintf("postgresql://%s@%s-a/%s", credentials, instance.ID, instance.DatabaseName),
+		"externalConnectionString": fmt.Sprintf("postgresql://%s@%s/%s", credentials, externalHost, instance.DatabaseName),
+		"psqlCommand":              fmt.Sprintf("PGPASSWORD=%s psql -h %s -U %s %s", instance.password, externalHost, instance.DatabaseUser, instance.DatabaseName),
+	})
+}
@@ -0,0 +1,160 @@
+package fakerender
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/sonlir/render-client-go"
+)
+
+type redis struct {
+	ID              string         `json:"id"`
+	CreatedAt       string         `json:"createdAt"`
+	IPAllowList     []ipAllowEntry `json:"ipAllowList"`
+	MaxmemoryPolicy string         `json:"maxmemoryPolicy"`
+	Name            string         `json:"name"`
+	Owner           render.Owner   `json:"owner"`
+	Plan            string         `json:"plan"`
+	Region          string         `json:"region"`
+	Status          string         `json:"status"`
+	UpdatedAt       string         `json:"updatedAt"`
+	Version         string         `json:"version"`
+}
+
+// redisInput is the body of a request to create or update a Redis instance.
+type redisInput struct {
+	IPAllowList     []ipAllowEntry `json:"ipAllowList"`
+	MaxmemoryPolicy string         `json:"maxmemoryPolicy"`
+	Name            string         `json:"name"`
+	OwnerID         string         `json:"ownerId"`
+	Plan            string         `json:"plan"`
+	Region          string         `json:"region"`
+}
+
+// RedisIDs returns the IDs of every Redis instance.
+func (s *Server) RedisIDs() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return sortedKeys(s.redis)
+}
+
+func (s *Server) listRedis(w http.ResponseWriter, r *http.Request) {
+	type redisItem struct {
+		Redis  *redis `json:"redis"`
+		Cursor string `json:"cursor"`
+	}
+	items := []redisItem{}
+	for _, id := range sortedKeys(s.redis) {
+		instance := s.redis[id]
+		if name := r.URL.Query().Get("name"); name != "" && instance.Name != name {
+			continue
+		}
+		if ownerID := r.URL.Query().Get("ownerId"); ownerID != "" && instance.Owner.ID != ownerID {
+			continue
+		}
+		items = append(items, redisItem{Redis: instance, Cursor: id})
+	}
+	writeJSON(w, http.StatusOK, items)
+}
+
+func (s *Server) createRedis(w http.ResponseWriter, r *http.Request) {
+	var input redisInput
+	if !readJSON(w, r, &input) {
+		return
+	}
+	if input.Name == "" || input.OwnerID == "" {
+		writeError(w, http.StatusBadRequest, "name and ownerId are required")
+		return
+	}
+	owner, ok := s.owners[input.OwnerID]
+	if !ok {
+		writeError(w, http.StatusBadRequest, "owner not found: "+input.OwnerID)
+		return
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	instance := &redis{
+		ID:              s.newID("red"),
+		CreatedAt:       now,
+		IPAllowList:     []ipAllowEntry{},
+		MaxmemoryPolicy: input.MaxmemoryPolicy,
+		Name:            input.Name,
+		Owner:           owner,
+		Plan:            input.Plan,
+		Region:          input.Region,
+		Status:          "available",
+		UpdatedAt:       now,
+		Version:         "7.2.4",
+	}
+	if input.IPAllowList != nil {
+		instance.IPAllowList = input.IPAllowList
+	}
+	if instance.MaxmemoryPolicy == "" {
+		instance.MaxmemoryPolicy = "allkeys_lru"
+	}
+	if instance.Plan == "" {
+		instance.Plan = "starter"
+	}
+	if instance.Region == "" {
+		instance.Region = "oregon"
+	}
+	s.redis[instance.ID] = instance
+	writeJSON(w, http.StatusCreated, instance)
+}
+
+func (s *Server) getRedis(w http.ResponseWriter, r *http.Request) {
+	instance, ok := s.redis[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "redis instance not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, instance)
+}
+
+func (s *Server) updateRedis(w http.ResponseWriter, r *http.Request) {
+	instance, ok := s.redis[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "redis instance not found")
+		return
+	}
+	var input redisInput
+	if !readJSON(w, r, &input) {
+		return
+	}
+	if input.OwnerID != "" || input.Region != "" {
+		writeError(w, http.StatusBadRequest, "ownerId and region can't be changed")
+		return
+	}
+	setString(&instance.Name, input.Name)
+	setString(&instance.Plan, input.Plan)
+	setString(&instance.MaxmemoryPolicy, input.MaxmemoryPolicy)
+	if input.IPAllowList != nil {
+		instance.IPAllowList = input.IPAllowList
+	}
+	instance.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+	writeJSON(w, http.StatusOK, instance)
+}
+
+func (s *Server) deleteRedis(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.redis[r.PathValue("id")]; !ok {
+		writeError(w, http.StatusNotFound, "redis instance not found")
+		return
+	}
+	delete(s.redis, r.PathValue("id"))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) getRedisConnectionInfo(w http.ResponseWriter, r *http.Request) {
+	instance, ok := s.redis[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "redis instance not found")
+		return
+	}
+	externalHost := fmt.Sprintf("%s.%s-redis.render.com:6379", instance.ID, instance.Region)
+	writeJSON(w, http.StatusOK, map[string]string{
+		"redisCLICommand":          "redis-cli --tls -u rediss://red:password@" + externalHost,
+		"internalConnectionString": fmt.Sprintf("redis://%s:6379", instance.ID),
+		"externalConnectionString": "rediss://red:password@" + externalHost,
+	})
+}
@@ -0,0 +1,1008 @@
+// Package fakerender implements an in-memory fake of the Render API, so that
+// the provider can be tested without network access or a Render account.
+//
+// The fake is stateful for every resource the provider manages. Tests point
+// the provider at Server.URL, and use the Server methods to seed data or to
+// change it behind the provider's back to simulate drift.
+package fakerender
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sonlir/render-client-go"
+)
+
+// APIKey is the only API key the fake accepts.
+const APIKey = "rnd_fake"
+
+const defaultLimit = 20
+
+type Server struct {
+	*httptest.Server
+
+	mu                  sync.Mutex
+	nextID              int
+	owners              map[string]render.Owner
+	registryCredentials map[string]render.RegistryCredential
+	services            map[string]*service
+	postgres            map[string]*postgres
+	redis               map[string]*redis
+	envGroups           map[string]*envGroup
+	disks               map[string]*disk
+	deployStatus        string
+}
+
+type service struct {
+	render.Service
+	envVars       []render.EnvironmentVariable
+	secretFiles   []render.SecretFiles
+	publishPath   string
+	headers       []render.Header
+	routes        []render.Route
+	customDomains []render.CustomDomain
+	deploys       []Deploy
+}
+
+// serviceJSON is a service the way the API returns it. render.ServiceDetails
+// has no field for the publish path of static sites.
+type serviceJSON struct {
+	render.Service
+	ServiceDetails serviceDetails `json:"serviceDetails"`
+}
+
+type serviceDetails struct {
+	render.ServiceDetails
+	PublishPath string `json:"publishPath,omitempty"`
+}
+
+// NewServer starts a fake Render API. The caller must call Close when done.
+func NewServer() *Server {
+	s := &Server{
+		owners:              map[string]render.Owner{},
+		registryCredentials: map[string]render.RegistryCredential{},
+		services:            map[string]*service{},
+		postgres:            map[string]*postgres{},
+		redis:               map[string]*redis{},
+		envGroups:           map[string]*envGroup{},
+		disks:               map[string]*disk{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /owners", s.listOwners)
+	mux.HandleFunc("GET /owners/{id}", s.getOwner)
+	mux.HandleFunc("GET /registrycredentials", s.listRegistryCredentials)
+	mux.HandleFunc("POST /registrycredentials", s.createRegistryCredential)
+	mux.HandleFunc("GET /registrycredentials/{id}", s.getRegistryCredential)
+	mux.HandleFunc("PATCH /registrycredentials/{id}", s.updateRegistryCredential)
+	mux.HandleFunc("DELETE /registrycredentials/{id}", s.deleteRegistryCredential)
+	mux.HandleFunc("GET /services", s.listServices)
+	mux.HandleFunc("POST /services", s.createService)
+	mux.HandleFunc("GET /services/{id}", s.getService)
+	mux.HandleFunc("PATCH /services/{id}", s.updateService)
+	mux.HandleFunc("DELETE /services/{id}", s.deleteService)
+	mux.HandleFunc("GET /services/{id}/env-vars", s.getEnvVars)
+	mux.HandleFunc("PUT /services/{id}/env-vars", s.updateEnvVars)
+	mux.HandleFunc("GET /services/{id}/env-vars/{key}", s.getEnvVar)
+	mux.HandleFunc("PUT /services/{id}/env-vars/{key}", s.updateEnvVar)
+	mux.HandleFunc("DELETE /services/{id}/env-vars/{key}", s.deleteEnvVar)
+	mux.HandleFunc("GET /services/{id}/secret-files", s.getSecretFiles)
+	mux.HandleFunc("GET /services/{id}/secret-files/{name}", s.getSecretFile)
+	mux.HandleFunc("PUT /services/{id}/secret-files/{name}", s.updateSecretFile)
+	mux.HandleFunc("DELETE /services/{id}/secret-files/{name}", s.deleteSecretFile)
+	mux.HandleFunc("PUT /services/{id}/autoscaling", s.updateAutoscaling)
+	mux.HandleFunc("POST /services/{id}/scale", s.scaleService)
+	mux.HandleFunc("GET /services/{id}/headers", s.getHeaders)
+	mux.HandleFunc("PUT /services/{id}/headers", s.updateHeaders)
+	mux.HandleFunc("GET /services/{id}/routes", s.getRoutes)
+	mux.HandleFunc("PUT /services/{id}/routes", s.updateRoutes)
+	mux.HandleFunc("GET /services/{id}/custom-domains", s.listCustomDomains)
+	mux.HandleFunc("POST /services/{id}/custom-domains", s.createCustomDomain)
+	mux.HandleFunc("GET /services/{id}/custom-domains/{idOrName}", s.getCustomDomain)
+	mux.HandleFunc("DELETE /services/{id}/custom-domains/{idOrName}", s.deleteCustomDomain)
+	mux.HandleFunc("POST /services/{id}/custom-domains/{idOrName}/verify", s.verifyCustomDomain)
+	mux.HandleFunc("GET /services/{id}/deploys", s.listDeploys)
+	mux.HandleFunc("POST /services/{id}/deploys", s.createDeploy)
+	mux.HandleFunc("GET /services/{id}/deploys/{deployId}", s.getDeploy)
+	mux.HandleFunc("GET /postgres", s.listPostgres)
+	mux.HandleFunc("POST /postgres", s.createPostgres)
+	mux.HandleFunc("GET /postgres/{id}", s.getPostgres)
+	mux.HandleFunc("PATCH /postgres/{id}", s.updatePostgres)
+	mux.HandleFunc("DELETE /postgres/{id}", s.deletePostgres)
+	mux.HandleFunc("GET /postgres/{id}/connection-info", s.getPostgresConnectionInfo)
+	mux.HandleFunc("GET /redis", s.listRedis)
+	mux.HandleFunc("POST /redis", s.createRedis)
+	mux.HandleFunc("GET /redis/{id}", s.getRedis)
+	mux.HandleFunc("PATCH /redis/{id}", s.updateRedis)
+	mux.HandleFunc("DELETE /redis/{id}", s.deleteRedis)
+	mux.HandleFunc("GET /redis/{id}/connection-info", s.getRedisConnectionInfo)
+	mux.HandleFunc("GET /env-groups", s.listEnvGroups)
+	mux.HandleFunc("POST /env-groups", s.createEnvGroup)
+	mux.HandleFunc("GET /env-groups/{id}", s.getEnvGroup)
+	mux.HandleFunc("PATCH /env-groups/{id}", s.updateEnvGroup)
+	mux.HandleFunc("DELETE /env-groups/{id}", s.deleteEnvGroup)
+	mux.HandleFunc("PUT /env-groups/{id}/env-vars/{key}", s.updateEnvGroupEnvVar)
+	mux.HandleFunc("DELETE /env-groups/{id}/env-vars/{key}", s.deleteEnvGroupEnvVar)
+	mux.HandleFunc("PUT /env-groups/{id}/secret-files/{name}", s.updateEnvGroupSecretFile)
+	mux.HandleFunc("DELETE /env-groups/{id}/secret-files/{name}", s.deleteEnvGroupSecretFile)
+	mux.HandleFunc("POST /env-groups/{id}/services/{serviceId}", s.linkEnvGroupService)
+	mux.HandleFunc("DELETE /env-groups/{id}/services/{serviceId}", s.unlinkEnvGroupService)
+	mux.HandleFunc("GET /disks", s.listDisks)
+	mux.HandleFunc("POST /disks", s.createDisk)
+	mux.HandleFunc("GET /disks/{id}", s.getDisk)
+	mux.HandleFunc("PATCH /disks/{id}", s.updateDisk)
+	mux.HandleFunc("DELETE /disks/{id}", s.deleteDisk)
+	mux.HandleFunc("GET /disks/{id}/snapshots", s.getDiskSnapshots)
+
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+APIKey {
+			writeError(w, http.StatusUnauthorized, "unauthorized")
+			return
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		mux.ServeHTTP(w, r)
+	}))
+	return s
+}
+
+// AddOwner adds an owner, which the API only lets you read.
+func (s *Server) AddOwner(owner render.Owner) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.owners[owner.ID] = owner
+}
+
+// AddRegistryCredential adds a registry credential and returns its ID.
+func (s *Server) AddRegistryCredential(registryCredential render.RegistryCredential) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	registryCredential.ID = s.newID("rgc")
+	registryCredential.AuthToken = nil
+	s.registryCredentials[registryCredential.ID] = registryCredential
+	return registryCredential.ID
+}
+
+// RegistryCredential returns the registry credential with the given ID.
+func (s *Server) RegistryCredential(id string) (render.RegistryCredential, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	registryCredential, ok := s.registryCredentials[id]
+	return registryCredential, ok
+}
+
+// UpdateRegistryCredential changes a registry credential without going
+// through the API.
+func (s *Server) UpdateRegistryCredential(id string, update func(*render.RegistryCredential)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	registryCredential, ok := s.registryCredentials[id]
+	if !ok {
+		panic("fakerender: unknown registry credential " + id)
+	}
+	update(&registryCredential)
+	s.registryCredentials[id] = registryCredential
+}
+
+// DeleteRegistryCredential deletes a registry credential without going
+// through the API.
+func (s *Server) DeleteRegistryCredential(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.registryCredentials, id)
+}
+
+// Service returns the service with the given ID, including its environment
+// variables and secret files.
+func (s *Server) Service(id string) (render.Service, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	svc, ok := s.services[id]
+	if !ok {
+		return render.Service{}, false
+	}
+	return svc.full(), true
+}
+
+// ServiceIDs returns the IDs of every service, in the order they were
+// created.
+func (s *Server) ServiceIDs() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ids := []string{}
+	for _, svc := range s.sortedServices() {
+		ids = append(ids, svc.ID)
+	}
+	return ids
+}
+
+// UpdateService changes a service without going through the API. Changes to
+// EnvVars and SecretFiles are kept as well.
+func (s *Server) UpdateService(id string, update func(*render.Service)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	svc, ok := s.services[id]
+	if !ok {
+		panic("fakerender: unknown service " + id)
+	}
+	full := svc.full()
+	update(&full)
+	svc.envVars = full.EnvVars
+	svc.secretFiles = full.SecretFiles
+	full.EnvVars = nil
+	full.SecretFiles = nil
+	svc.Service = full
+}
+
+// DeleteService deletes a service without going through the API.
+func (s *Server) DeleteService(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.removeService(id)
+}
+
+// removeService deletes a service along with its disk and its links to
+// environment groups, as Render does.
+func (s *Server) removeService(id string) {
+	delete(s.services, id)
+	for diskID, disk := range s.disks {
+		if disk.ServiceID == id {
+			delete(s.disks, diskID)
+		}
+	}
+	for _, group := range s.envGroups {
+		group.unlink(id)
+	}
+}
+
+func (svc *service) full() render.Service {
+	full := svc.Service
+	full.EnvVars = append([]render.EnvironmentVariable{}, svc.envVars...)
+	full.SecretFiles = append([]render.SecretFiles{}, svc.secretFiles...)
+	return full
+}
+
+// json returns the service the way the API returns it, without its
+// environment variables and secret files.
+func (svc *service) json() serviceJSON {
+	return serviceJSON{
+		Service: svc.Service,
+		ServiceDetails: serviceDetails{
+			ServiceDetails: svc.Service.ServiceDetails,
+			PublishPath:    svc.publishPath,
+		},
+	}
+}
+
+// newID returns a new ID with the given prefix, in the format Render uses.
+func (s *Server) newID(prefix string) string {
+	s.nextID++
+	return fmt.Sprintf("%s-%020d", prefix, s.nextID)
+}
+
+func (s *Server) listOwners(w http.ResponseWriter, r *http.Request) {
+	type ownerItem struct {
+		Cursor string       `json:"cursor"`
+		Owner  render.Owner `json:"owner"`
+	}
+	ids := []string{}
+	for id := range s.owners {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	items := []ownerItem{}
+	for _, id := range ids {
+		owner := s.owners[id]
+		if name := r.URL.Query().Get("name"); name != "" && owner.Name != name {
+			continue
+		}
+		if email := r.URL.Query().Get("email"); email != "" && owner.Email != email {
+			continue
+		}
+		items = append(items, ownerItem{Cursor: owner.ID, Owner: owner})
+	}
+	writeJSON(w, http.StatusOK, items)
+}
+
+func (s *Server) getOwner(w http.ResponseWriter, r *http.Request) {
+	owner, ok := s.owners[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "owner not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, owner)
+}
+
+func (s *Server) listRegistryCredentials(w http.ResponseWriter, r *http.Request) {
+	ids := []string{}
+	for id := range s.registryCredentials {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	items := []render.RegistryCredential{}
+	for _, id := range ids {
+		registryCredential := s.registryCredentials[id]
+		if name := r.URL.Query().Get("name"); name != "" && registryCredential.Name != name {
+			continue
+		}
+		items = append(items, registryCredential)
+	}
+	writeJSON(w, http.StatusOK, items)
+}
+
+func (s *Server) createRegistryCredential(w http.ResponseWriter, r *http.Request) {
+	var data render.RegistryCredential
+	if !readJSON(w, r, &data) {
+		return
+	}
+	if data.Name == "" || data.Username == "" || data.AuthToken == nil || data.OwnerId == nil {
+		writeError(w, http.StatusBadRequest, "name, registry, username, authToken and ownerId are required")
+		return
+	}
+	if !validRegistry(data.Registry) {
+		writeError(w, http.StatusBadRequest, "invalid registry: "+data.Registry)
+		return
+	}
+
+	data.ID = s.newID("rgc")
+	// The auth token is write only.
+	data.AuthToken = nil
+	s.registryCredentials[data.ID] = data
+	writeJSON(w, http.StatusCreated, data)
+}
+
+func (s *Server) getRegistryCredential(w http.ResponseWriter, r *http.Request) {
+	registryCredential, ok := s.registryCredentials[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "registry credential not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, registryCredential)
+}
+
+func (s *Server) updateRegistryCredential(w http.ResponseWriter, r *http.Request) {
+	registryCredential, ok := s.registryCredentials[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "registry credential not found")
+		return
+	}
+	var data render.RegistryCredential
+	if !readJSON(w, r, &data) {
+		return
+	}
+	if data.Registry != "" && !validRegistry(data.Registry) {
+		writeError(w, http.StatusBadRequest, "invalid registry: "+data.Registry)
+		return
+	}
+
+	setString(&registryCredential.Name, data.Name)
+	setString(&registryCredential.Registry, data.Registry)
+	setString(&registryCredential.Username, data.Username)
+	if data.OwnerId != nil {
+		registryCredential.OwnerId = data.OwnerId
+	}
+	s.registryCredentials[registryCredential.ID] = registryCredential
+	writeJSON(w, http.StatusOK, registryCredential)
+}
+
+func (s *Server) deleteRegistryCredential(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.registryCredentials[r.PathValue("id")]; !ok {
+		writeError(w, http.StatusNotFound, "registry credential not found")
+		return
+	}
+	delete(s.registryCredentials, r.PathValue("id"))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listServices(w http.ResponseWriter, r *http.Request) {
+	type serviceItem struct {
+		Cursor  string      `json:"cursor"`
+		Service serviceJSON `json:"service"`
+	}
+	query := r.URL.Query()
+	limit := defaultLimit
+	if v := query.Get("limit"); v != "" {
+		var err error
+		limit, err = strconv.Atoi(v)
+		if err != nil || limit < 1 || limit > 100 {
+			writeError(w, http.StatusBadRequest, "limit must be between 1 and 100")
+			return
+		}
+	}
+
+	items := []serviceItem{}
+	afterCursor := query.Get("cursor") == ""
+	for _, svc := range s.sortedServices() {
+		if !afterCursor {
+			afterCursor = svc.ID == query.Get("cursor")
+			continue
+		}
+		if name := query.Get("name"); name != "" && svc.Name != name {
+			continue
+		}
+		if serviceType := query.Get("type"); serviceType != "" && svc.Type != serviceType {
+			continue
+		}
+		if ownerID := query.Get("ownerId"); ownerID != "" && svc.OwnerID != ownerID {
+			continue
+		}
+		if len(items) == limit {
+			break
+		}
+		items = append(items, serviceItem{Cursor: svc.ID, Service: svc.json()})
+	}
+	writeJSON(w, http.StatusOK, items)
+}
+
+func (s *Server) createService(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		render.Service
+		ServiceDetails serviceDetails `json:"serviceDetails"`
+		EnvVars        []envVarInput  `json:"envVars"`
+	}
+	if !readJSON(w, r, &request) {
+		return
+	}
+	data := request.Service
+	data.ServiceDetails = request.ServiceDetails.ServiceDetails
+	if data.Name == "" || data.OwnerID == "" || data.Type == "" {
+		writeError(w, http.StatusBadRequest, "name, ownerId and type are required")
+		return
+	}
+
+	envVars, ok := s.makeEnvVars(w, request.EnvVars)
+	if !ok {
+		return
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	svc := &service{Service: data, envVars: envVars, secretFiles: data.SecretFiles}
+	svc.ID = s.newID("srv")
+	svc.Slug = slug(data.Name)
+	svc.CreateAt = now
+	svc.UpdatedAt = now
+	svc.Suspended = "not_suspended"
+	svc.Suspenders = []string{}
+	svc.NotifyOnFail = "default"
+	svc.EnvVars = nil
+	svc.SecretFiles = nil
+	if svc.AutoDeploy == "" {
+		svc.AutoDeploy = "yes"
+	}
+	if svc.Repo != "" && svc.Branch == "" {
+		svc.Branch = "main"
+	}
+	if svc.Image != nil {
+		svc.ImagePath = svc.Image.ImagePath
+	}
+
+	details := &svc.ServiceDetails
+	if details.Plan == "" {
+		details.Plan = "starter"
+	}
+	if details.Region == "" {
+		details.Region = "oregon"
+	}
+	if details.NumInstances == 0 {
+		details.NumInstances = 1
+	}
+	if details.PullRequestPreviewsEnabled == "" {
+		details.PullRequestPreviewsEnabled = "no"
+	}
+	switch data.Type {
+	case "web_service":
+		details.URL = "https://" + svc.Slug + ".onrender.com"
+		details.OpenPorts = []render.OpenPort{}
+	case "private_service":
+		details.URL = svc.Slug + ":10000"
+		details.OpenPorts = []render.OpenPort{}
+	case "static_site":
+		details.URL = "https://" + svc.Slug + ".onrender.com"
+		svc.publishPath = request.ServiceDetails.PublishPath
+		if svc.publishPath == "" {
+			svc.publishPath = "public"
+		}
+		// Headers and routes are read through their own endpoints.
+		svc.headers = details.Headers
+		svc.routes = details.Routes
+		details.Headers = nil
+		details.Routes = nil
+	}
+	if details.Env == "docker" {
+		if details.EnvSpecificDetails == nil {
+			details.EnvSpecificDetails = &render.EnvSpecificDetails{}
+		}
+		setDefault(&details.EnvSpecificDetails.DockerCommand, "")
+		setDefault(&details.EnvSpecificDetails.DockerContext, ".")
+		setDefault(&details.EnvSpecificDetails.DockerfilePath, "./Dockerfile")
+	}
+	// A disk in the request is created along with the service, autoscaling
+	// is managed through its own endpoint.
+	if details.Disk != nil {
+		disk := s.addDisk(svc.ID, *details.Disk)
+		details.Disk = &render.Disk{Id: disk.ID, Name: disk.Name}
+	}
+	details.Autoscaling = nil
+
+	// Render deploys every new service.
+	svc.deploys = []Deploy{s.newDeploy(svc, deployInput{})}
+
+	s.services[svc.ID] = svc
+	created := svc.json()
+	created.EnvVars = newestFirst(svc.envVars)
+	writeJSON(w, http.StatusCreated, created)
+}
+
+func (s *Server) getService(w http.ResponseWriter, r *http.Request) {
+	svc, ok := s.services[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "service not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, svc.json())
+}
+
+func (s *Server) updateService(w http.ResponseWriter, r *http.Request) {
+	svc, ok := s.services[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "service not found")
+		return
+	}
+	var request struct {
+		render.Service
+		ServiceDetails serviceDetails `json:"serviceDetails"`
+	}
+	if !readJSON(w, r, &request) {
+		return
+	}
+	data := request.Service
+
+	setString(&svc.Name, data.Name)
+	setString(&svc.AutoDeploy, data.AutoDeploy)
+	setString(&svc.Branch, data.Branch)
+	setString(&svc.Repo, data.Repo)
+	setString(&svc.RootDir, data.RootDir)
+	if data.BuildFilter != nil {
+		svc.BuildFilter = data.BuildFilter
+	}
+	if data.Image != nil {
+		svc.Image = data.Image
+		svc.ImagePath = data.Image.ImagePath
+	}
+
+	// The number of instances is changed with the scale endpoint, headers
+	// and routes with their own endpoints.
+	details := &svc.ServiceDetails
+	update := request.ServiceDetails
+	setString(&details.Plan, update.Plan)
+	setString(&details.HealthCheckPath, update.HealthCheckPath)
+	setString(&details.PullRequestPreviewsEnabled, update.PullRequestPreviewsEnabled)
+	setString(&details.Schedule, update.Schedule)
+	setString(&details.BuildCommand, update.BuildCommand)
+	setString(&svc.publishPath, update.PublishPath)
+	if update.EnvSpecificDetails != nil {
+		if details.EnvSpecificDetails == nil {
+			details.EnvSpecificDetails = &render.EnvSpecificDetails{}
+		}
+		envSpecific := update.EnvSpecificDetails
+		setPointer(&details.EnvSpecificDetails.BuildCommand, envSpecific.BuildCommand)
+		setPointer(&details.EnvSpecificDetails.StartCommand, envSpecific.StartCommand)
+		setPointer(&details.EnvSpecificDetails.PreDeployCommand, envSpecific.PreDeployCommand)
+		setPointer(&details.EnvSpecificDetails.DockerCommand, envSpecific.DockerCommand)
+		setPointer(&details.EnvSpecificDetails.DockerContext, envSpecific.DockerContext)
+		setPointer(&details.EnvSpecificDetails.DockerfilePath, envSpecific.DockerfilePath)
+	}
+	svc.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+
+	writeJSON(w, http.StatusOK, svc.json())
+}
+
+func (s *Server) deleteService(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.services[r.PathValue("id")]; !ok {
+		writeError(w, http.StatusNotFound, "service not found")
+		return
+	}
+	s.removeService(r.PathValue("id"))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type envVarItem struct {
+	EnvVar render.EnvironmentVariable `json:"envVar"`
+	Cursor string                     `json:"cursor"`
+}
+
+func (s *Server) getEnvVars(w http.ResponseWriter, r *http.Request) {
+	svc, ok := s.services[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "service not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, envVarItems(svc.envVars))
+}
+
+func (s *Server) updateEnvVars(w http.ResponseWriter, r *http.Request) {
+	svc, ok := s.services[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "service not found")
+		return
+	}
+	var inputs []envVarInput
+	if !readJSON(w, r, &inputs) {
+		return
+	}
+	envVars, ok := s.makeEnvVars(w, inputs)
+	if !ok {
+		return
+	}
+	svc.envVars = envVars
+	writeJSON(w, http.StatusOK, envVarItems(svc.envVars))
+}
+
+func (s *Server) getEnvVar(w http.ResponseWriter, r *http.Request) {
+	svc, ok := s.services[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "service not found")
+		return
+	}
+	for _, envVar := range svc.envVars {
+		if envVar.Key == r.PathValue("key") {
+			writeJSON(w, http.StatusOK, envVar)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "environment variable not found")
+}
+
+// updateEnvVar updates the variable in place, or adds it as the newest one.
+func (s *Server) updateEnvVar(w http.ResponseWriter, r *http.Request) {
+	svc, ok := s.services[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "service not found")
+		return
+	}
+	var input envVarInput
+	if !readJSON(w, r, &input) {
+		return
+	}
+	input.Key = r.PathValue("key")
+	envVars, ok := s.makeEnvVars(w, []envVarInput{input})
+	if !ok {
+		return
+	}
+	for i := range svc.envVars {
+		if svc.envVars[i].Key == input.Key {
+			svc.envVars[i] = envVars[0]
+			writeJSON(w, http.StatusOK, envVars[0])
+			return
+		}
+	}
+	svc.envVars = append(svc.envVars, envVars[0])
+	writeJSON(w, http.StatusOK, envVars[0])
+}
+
+func (s *Server) deleteEnvVar(w http.ResponseWriter, r *http.Request) {
+	svc, ok := s.services[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "service not found")
+		return
+	}
+	for i := range svc.envVars {
+		if svc.envVars[i].Key == r.PathValue("key") {
+			svc.envVars = append(svc.envVars[:i], svc.envVars[i+1:]...)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "environment variable not found")
+}
+
+// envVarInput is an environment variable in a request, whose value Render
+// generates if GenerateValue is set.
+type envVarInput struct {
+	Key           string  `json:"key"`
+	Value         *string `json:"value"`
+	GenerateValue bool    `json:"generateValue"`
+}
+
+// makeEnvVars generates the values of inputs that ask for it, and rejects the
+// inputs the API would reject.
+func (s *Server) makeEnvVars(w http.ResponseWriter, inputs []envVarInput) ([]render.EnvironmentVariable, bool) {
+	envVars := []render.EnvironmentVariable{}
+	for _, input := range inputs {
+		if input.Key == "" || (input.Value == nil) == !input.GenerateValue {
+			writeError(w, http.StatusBadRequest, "each environment variable needs a key and either a value or generateValue")
+			return nil, false
+		}
+		envVar := render.EnvironmentVariable{Key: input.Key}
+		if input.GenerateValue {
+			envVar.Value = s.newID("generated")
+		} else {
+			envVar.Value = *input.Value
+		}
+		envVars = append(envVars, envVar)
+	}
+	return envVars, true
+}
+
+func envVarItems(envVars []render.EnvironmentVariable) []envVarItem {
+	items := []envVarItem{}
+	for _, envVar := range newestFirst(envVars) {
+		items = append(items, envVarItem{EnvVar: envVar, Cursor: envVar.Key})
+	}
+	return items
+}
+
+// newestFirst orders environment variables the way the API returns them.
+func newestFirst(envVars []render.EnvironmentVariable) []render.EnvironmentVariable {
+	result := []render.EnvironmentVariable{}
+	for i := len(envVars) - 1; i >= 0; i-- {
+		result = append(result, envVars[i])
+	}
+	return result
+}
+
+func (s *Server) getSecretFiles(w http.ResponseWriter, r *http.Request) {
+	type secretFileItem struct {
+		SecretFile secretFile `json:"secretFile"`
+		Cursor     string     `json:"cursor"`
+	}
+	svc, ok := s.services[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "service not found")
+		return
+	}
+	items := []secretFileItem{}
+	for _, file := range svc.secretFiles {
+		items = append(items, secretFileItem{
+			SecretFile: secretFile{Name: file.Name, Content: file.Contents},
+			Cursor:     file.Name,
+		})
+	}
+	writeJSON(w, http.StatusOK, items)
+}
+
+type secretFile struct {
+	Name    string `json:"name"`
+	Content string `json:"content"`
+}
+
+func (s *Server) getSecretFile(w http.ResponseWriter, r *http.Request) {
+	svc, ok := s.services[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "service not found")
+		return
+	}
+	for _, file := range svc.secretFiles {
+		if file.Name == r.PathValue("name") {
+			writeJSON(w, http.StatusOK, secretFile{Name: file.Name, Content: file.Contents})
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "secret file not found")
+}
+
+func (s *Server) updateSecretFile(w http.ResponseWriter, r *http.Request) {
+	svc, ok := s.services[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "service not found")
+		return
+	}
+	var data secretFile
+	if !readJSON(w, r, &data) {
+		return
+	}
+	file := render.SecretFiles{Name: r.PathValue("name"), Contents: data.Content}
+	updated := false
+	for i := range svc.secretFiles {
+		if svc.secretFiles[i].Name == file.Name {
+			svc.secretFiles[i] = file
+			updated = true
+		}
+	}
+	if !updated {
+		svc.secretFiles = append(svc.secretFiles, file)
+	}
+	writeJSON(w, http.StatusOK, secretFile{Name: file.Name, Content: file.Contents})
+}
+
+func (s *Server) deleteSecretFile(w http.ResponseWriter, r *http.Request) {
+	svc, ok := s.services[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "service not found")
+		return
+	}
+	for i := range svc.secretFiles {
+		if svc.secretFiles[i].Name == r.PathValue("name") {
+			svc.secretFiles = append(svc.secretFiles[:i], svc.secretFiles[i+1:]...)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "secret file not found")
+}
+
+func (s *Server) updateAutoscaling(w http.ResponseWriter, r *http.Request) {
+	svc, ok := s.services[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "service not found")
+		return
+	}
+	var autoscaling render.Autoscaling
+	if !readJSON(w, r, &autoscaling) {
+		return
+	}
+	svc.ServiceDetails.Autoscaling = &autoscaling
+	writeJSON(w, http.StatusOK, autoscaling)
+}
+
+func (s *Server) scaleService(w http.ResponseWriter, r *http.Request) {
+	svc, ok := s.services[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "service not found")
+		return
+	}
+	var scale render.Scale
+	if !readJSON(w, r, &scale) {
+		return
+	}
+	if scale.NumInstances < 1 {
+		writeError(w, http.StatusBadRequest, "numInstances must be at least 1")
+		return
+	}
+	svc.ServiceDetails.NumInstances = scale.NumInstances
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (s *Server) getHeaders(w http.ResponseWriter, r *http.Request) {
+	type headerItem struct {
+		Header render.Header `json:"header"`
+		Cursor string        `json:"cursor"`
+	}
+	svc, ok := s.services[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "service not found")
+		return
+	}
+	items := []headerItem{}
+	for i, header := range svc.headers {
+		items = append(items, headerItem{Header: header, Cursor: strconv.Itoa(i)})
+	}
+	writeJSON(w, http.StatusOK, items)
+}
+
+func (s *Server) updateHeaders(w http.ResponseWriter, r *http.Request) {
+	svc, ok := s.services[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "service not found")
+		return
+	}
+	var headers []render.Header
+	if !readJSON(w, r, &headers) {
+		return
+	}
+	for _, header := range headers {
+		if header.Path == "" || header.Name == "" {
+			writeError(w, http.StatusBadRequest, "each header needs a path and a name")
+			return
+		}
+	}
+	svc.headers = headers
+	writeJSON(w, http.StatusOK, headers)
+}
+
+func (s *Server) getRoutes(w http.ResponseWriter, r *http.Request) {
+	type routeItem struct {
+		Route  render.Route `json:"route"`
+		Cursor string       `json:"cursor"`
+	}
+	svc, ok := s.services[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "service not found")
+		return
+	}
+	items := []routeItem{}
+	for i, route := range svc.routes {
+		items = append(items, routeItem{Route: route, Cursor: strconv.Itoa(i)})
+	}
+	writeJSON(w, http.StatusOK, items)
+}
+
+func (s *Server) updateRoutes(w http.ResponseWriter, r *http.Request) {
+	svc, ok := s.services[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "service not found")
+		return
+	}
+	var routes []render.Route
+	if !readJSON(w, r, &routes) {
+		return
+	}
+	for _, route := range routes {
+		if (route.Type != "redirect" && route.Type != "rewrite") || route.Source == "" || route.Destination == "" {
+			writeError(w, http.StatusBadRequest, "each route needs a type of redirect or rewrite, a source and a destination")
+			return
+		}
+	}
+	svc.routes = routes
+	writeJSON(w, http.StatusOK, routes)
+}
+
+func (s *Server) sortedServices() []*service {
+	services := []*service{}
+	for _, svc := range s.services {
+		services = append(services, svc)
+	}
+	sort.Slice(services, func(i, j int) bool {
+		return services[i].ID < services[j].ID
+	})
+	return services
+}
+
+// sortedKeys returns the keys of m in ascending order, which is also the
+// order the values were created in.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := []string{}
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+var slugInvalid = regexp.MustCompile(`[^a-z0-9]+`)
+
+func slug(name string) string {
+	return strings.Trim(slugInvalid.ReplaceAllString(strings.ToLower(name), "-"), "-")
+}
+
+func validRegistry(registry string) bool {
+	return registry == "GITHUB" || registry == "GITLAB" || registry == "DOCKER"
+}
+
+// setString updates *field unless value is empty, as PATCH requests leave
+// out the fields they don't change.
+func setString(field *string, value string) {
+	if value != "" {
+		*field = value
+	}
+}
+
+func setPointer(field **string, value *string) {
+	if value != nil {
+		*field = value
+	}
+}
+
+func setDefault(field **string, value string) {
+	if *field == nil {
+		*field = &value
+	}
+}
+
+func readJSON(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"message": message})
+}
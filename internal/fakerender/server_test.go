@@ -0,0 +1,505 @@
+package fakerender
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/sonlir/render-client-go"
+
+	"terraform-provider-render/internal/api"
+)
+
+func newTestClient(t *testing.T, server *Server) *api.Client {
+	t.Helper()
+	apiKey := APIKey
+	client, err := render.NewClient(&apiKey, &server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return api.NewClient(client)
+}
+
+func TestServerRejectsWrongAPIKey(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	apiKey := "rnd_wrong"
+	client, err := render.NewClient(&apiKey, &server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetOwners(nil); err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestServerOwners(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.AddOwner(render.Owner{ID: "usr-1", Name: "Jane", Email: "jane@example.com", Type: "user"})
+	server.AddOwner(render.Owner{ID: "tea-1", Name: "Team", Email: "team@example.com", Type: "team"})
+	client := newTestClient(t, server)
+
+	owners, err := client.GetOwners(&render.GetOwnersArgs{Email: "team@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(owners) != 1 || owners[0].ID != "tea-1" {
+		t.Errorf("unexpected owners: %+v", owners)
+	}
+
+	owner, err := client.GetOwner("usr-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if owner.Name != "Jane" {
+		t.Errorf("unexpected owner: %+v", owner)
+	}
+
+	_, err = client.GetOwner("usr-2")
+	if !api.IsNotFound(err) {
+		t.Errorf("expected not found, got %v", err)
+	}
+}
+
+func TestServerRegistryCredentials(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := newTestClient(t, server)
+
+	ownerID, authToken := "usr-1", "token"
+	created, err := client.CreateRegistryCredential(render.RegistryCredential{
+		Name:      "docker",
+		Registry:  "DOCKER",
+		Username:  "jane",
+		AuthToken: &authToken,
+		OwnerId:   &ownerID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.ID == "" || created.AuthToken != nil {
+		t.Errorf("unexpected registry credential: %+v", created)
+	}
+
+	_, err = client.CreateRegistryCredential(render.RegistryCredential{
+		Name:      "docker",
+		Registry:  "DOCKER",
+		Username:  "jane",
+		AuthToken: &authToken,
+		OwnerId:   &ownerID,
+	})
+	if err == nil {
+		t.Error("expected an error for a duplicate name")
+	}
+
+	updated, err := client.UpdateRegistryCredential(created.ID, render.RegistryCredential{Name: "github", Registry: "GITHUB", Username: "jane"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Name != "github" || updated.Registry != "GITHUB" || *updated.OwnerId != ownerID {
+		t.Errorf("unexpected registry credential: %+v", updated)
+	}
+
+	server.UpdateRegistryCredential(created.ID, func(registryCredential *render.RegistryCredential) {
+		registryCredential.Username = "john"
+	})
+	read, err := client.GetRegistryCredential(created.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if read.Username != "john" {
+		t.Errorf("expected the change made on the server, got %+v", read)
+	}
+
+	if err := client.DeleteRegistryCredential(created.ID); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.GetRegistryCredential(created.ID)
+	if !api.IsNotFound(err) {
+		t.Errorf("expected not found, got %v", err)
+	}
+}
+
+func TestServerServices(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := newTestClient(t, server)
+
+	value := "1"
+	created, err := client.CreateService(api.ServiceData{
+		Service: render.Service{
+			Name:    "My App",
+			OwnerID: "usr-1",
+			Type:    "web_service",
+			Repo:    "https://github.com/render-examples/express-hello-world",
+			ServiceDetails: render.ServiceDetails{
+				Env:         "node",
+				Autoscaling: &render.Autoscaling{Enabled: true, Min: 1, Max: 2},
+			},
+		},
+		EnvVars: []api.EnvVarInput{
+			{Key: "A", EnvVarValue: api.EnvVarValue{Value: &value}},
+			{Key: "SECRET", EnvVarValue: api.EnvVarValue{GenerateValue: true}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.Slug != "my-app" || created.Branch != "main" || created.ServiceDetails.Plan != "starter" || created.ServiceDetails.NumInstances != 1 {
+		t.Errorf("expected defaults to be filled, got %+v", created)
+	}
+	if created.ServiceDetails.Autoscaling == nil || created.ServiceDetails.Autoscaling.Max != 2 {
+		t.Errorf("expected autoscaling, got %+v", created.ServiceDetails.Autoscaling)
+	}
+	if len(created.EnvVars) != 2 || created.EnvVars[0].Key != "SECRET" || created.EnvVars[0].Value == "" || created.EnvVars[1].Value != "1" {
+		t.Errorf("expected a generated value, got %+v", created.EnvVars)
+	}
+
+	_, err = client.CreateService(api.ServiceData{Service: render.Service{Name: "My App", OwnerID: "usr-1", Type: "web_service"}})
+	if err == nil {
+		t.Error("expected an error for a duplicate name")
+	}
+
+	value = "2"
+	updated, err := client.UpdateService(created.ID, api.ServiceData{
+		Service: render.Service{
+			Name: "My App",
+			Type: "web_service",
+			ServiceDetails: render.ServiceDetails{
+				Plan:         "standard",
+				NumInstances: 3,
+			},
+		},
+		EnvVars: []api.EnvVarInput{{Key: "B", EnvVarValue: api.EnvVarValue{Value: &value}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.ServiceDetails.Plan != "standard" || len(updated.EnvVars) != 1 || updated.EnvVars[0].Key != "B" {
+		t.Errorf("unexpected service: %+v", updated)
+	}
+
+	_, err = client.UpdateServiceEnvVars(created.ID, []api.EnvVarInput{{Key: "C"}})
+	if err == nil {
+		t.Error("expected an error for an environment variable without a value")
+	}
+
+	read, err := client.GetService(created.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if read.ServiceDetails.NumInstances != 3 {
+		t.Errorf("expected the service to be scaled, got %d instances", read.ServiceDetails.NumInstances)
+	}
+
+	server.UpdateService(created.ID, func(service *render.Service) {
+		service.SecretFiles = []render.SecretFiles{{Name: "secret.txt", Contents: "s3cr3t"}}
+	})
+	secretFiles, err := client.GetServiceSecretFiles(created.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(secretFiles) != 1 || secretFiles[0].Contents != "s3cr3t" {
+		t.Errorf("unexpected secret files: %+v", secretFiles)
+	}
+
+	if err := client.DeleteService(created.ID); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.GetService(created.ID)
+	if !api.IsNotFound(err) {
+		t.Errorf("expected not found, got %v", err)
+	}
+}
+
+func TestServerListServices(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := newTestClient(t, server)
+
+	// More services than fit in one page.
+	for i := 0; i < 105; i++ {
+		serviceType := "web_service"
+		if i%5 == 0 {
+			serviceType = "background_worker"
+		}
+		_, err := client.CreateService(api.ServiceData{Service: render.Service{Name: fmt.Sprintf("service-%d", i), OwnerID: "usr-1", Type: serviceType}})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	services, err := client.ListServices(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(services) != 105 {
+		t.Errorf("expected 105 services, got %d", len(services))
+	}
+
+	services, err = client.ListServices(&api.ListServicesArgs{Type: "background_worker"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(services) != 21 {
+		t.Errorf("expected 21 background workers, got %d", len(services))
+	}
+}
+
+func TestServerServiceEnvVarsAndSecretFiles(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := newTestClient(t, server)
+
+	value := "1"
+	created, err := client.CreateService(api.ServiceData{
+		Service: render.Service{Name: "my-app", OwnerID: "usr-1", Type: "web_service"},
+		EnvVars: []api.EnvVarInput{{Key: "A", EnvVarValue: api.EnvVarValue{Value: &value}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	envVar, err := client.UpdateServiceEnvVar(created.ID, "B", api.EnvVarValue{GenerateValue: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if envVar.Key != "B" || envVar.Value == "" {
+		t.Errorf("expected a generated value, got %+v", envVar)
+	}
+	value = "2"
+	if _, err := client.UpdateServiceEnvVar(created.ID, "A", api.EnvVarValue{Value: &value}); err != nil {
+		t.Fatal(err)
+	}
+	envVar, err = client.GetServiceEnvVar(created.ID, "A")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if envVar.Value != "2" {
+		t.Errorf("expected the value to be updated, got %+v", envVar)
+	}
+	if err := client.DeleteServiceEnvVar(created.ID, "A"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetServiceEnvVar(created.ID, "A"); !api.IsNotFound(err) {
+		t.Errorf("expected not found, got %v", err)
+	}
+	envVars, err := client.GetEnvironmentVariables(created.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(envVars) != 1 || envVars[0].Key != "B" {
+		t.Errorf("expected only B to be left, got %+v", envVars)
+	}
+
+	if _, err := client.UpdateServiceSecretFile(created.ID, "secret.txt", "s3cr3t"); err != nil {
+		t.Fatal(err)
+	}
+	secretFile, err := client.GetServiceSecretFile(created.ID, "secret.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if secretFile.Name != "secret.txt" || secretFile.Content != "s3cr3t" {
+		t.Errorf("unexpected secret file: %+v", secretFile)
+	}
+	if err := client.DeleteServiceSecretFile(created.ID, "secret.txt"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetServiceSecretFile(created.ID, "secret.txt"); !api.IsNotFound(err) {
+		t.Errorf("expected not found, got %v", err)
+	}
+}
+
+func TestServerPostgres(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.AddOwner(render.Owner{ID: "usr-1", Name: "Jane", Email: "jane@example.com", Type: "user"})
+	client := newTestClient(t, server)
+
+	created, err := client.CreatePostgres(api.PostgresData{
+		Name:         "My DB",
+		OwnerID:      "usr-1",
+		ReadReplicas: []api.PostgresReadReplica{{Name: "replica"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.DatabaseName != "my_db" || created.Plan != "starter" || created.Version != "16" {
+		t.Errorf("expected defaults to be filled, got %+v", created)
+	}
+	if len(created.ReadReplicas) != 1 || created.ReadReplicas[0].ID == "" {
+		t.Fatalf("expected a read replica with an ID, got %+v", created.ReadReplicas)
+	}
+
+	_, err = client.UpdatePostgres(created.ID, api.PostgresData{EnableHighAvailability: true})
+	if err == nil {
+		t.Error("expected an error for high availability on a starter plan")
+	}
+
+	updated, err := client.UpdatePostgres(created.ID, api.PostgresData{
+		Plan:                   "pro",
+		EnableHighAvailability: true,
+		ReadReplicas:           []api.PostgresReadReplica{{Name: "replica"}, {Name: "other"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !updated.HighAvailabilityEnabled || len(updated.ReadReplicas) != 2 || updated.ReadReplicas[0].ID != created.ReadReplicas[0].ID {
+		t.Errorf("expected existing replicas to keep their ID, got %+v", updated)
+	}
+
+	connectionInfo, err := client.GetPostgresConnectionInfo(created.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if connectionInfo.Password == "" || connectionInfo.InternalConnectionString == "" {
+		t.Errorf("unexpected connection info: %+v", connectionInfo)
+	}
+
+	if err := client.DeletePostgres(created.ID); err != nil {
+		t.Fatal(err)
+	}
+	if ids := server.PostgresIDs(); len(ids) != 0 {
+		t.Errorf("expected no instances, got %v", ids)
+	}
+}
+
+func TestServerDisks(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := newTestClient(t, server)
+
+	service, err := client.CreateService(api.ServiceData{Service: render.Service{Name: "app", OwnerID: "usr-1", Type: "web_service"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	created, err := client.CreateDisk(api.DiskData{Name: "data", MountPath: "/var/data", ServiceID: service.ID, SizeGB: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.CreateDisk(api.DiskData{Name: "more", MountPath: "/var/more", ServiceID: service.ID}); err == nil {
+		t.Error("expected an error for a second disk")
+	}
+	if _, err := client.UpdateDisk(created.ID, api.DiskData{SizeGB: 1}); err == nil {
+		t.Error("expected an error for a smaller disk")
+	}
+
+	server.AddDiskSnapshot(created.ID)
+	snapshots, err := client.GetDiskSnapshots(created.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snapshots) != 1 || snapshots[0].SnapshotKey == "" {
+		t.Errorf("unexpected snapshots: %+v", snapshots)
+	}
+
+	if err := client.DeleteService(service.ID); err != nil {
+		t.Fatal(err)
+	}
+	if ids := server.DiskIDs(); len(ids) != 0 {
+		t.Errorf("expected the disk to be deleted with the service, got %v", ids)
+	}
+}
+
+func TestServerDeploys(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := newTestClient(t, server)
+
+	service, err := client.CreateService(api.ServiceData{Service: render.Service{Name: "app", OwnerID: "usr-1", Type: "web_service"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	created, err := client.CreateDeploy(service.ID, api.DeployData{CommitID: "abc123"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.Status != "live" || created.Commit == nil || created.Commit.ID != "abc123" {
+		t.Errorf("unexpected deploy: %+v", created)
+	}
+
+	server.SetDeployStatus("build_failed")
+	if _, err := client.CreateDeploy(service.ID, api.DeployData{}); err != nil {
+		t.Fatal(err)
+	}
+	deploys, err := client.GetDeploys(service.ID, &api.GetDeploysArgs{Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deploys) != 1 || deploys[0].Status != "build_failed" {
+		t.Errorf("expected the latest deploy first, got %+v", deploys)
+	}
+
+	read, err := client.GetDeploy(service.ID, created.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if read.Status != "live" {
+		t.Errorf("expected the failed deploy to leave the live one alone, got %s", read.Status)
+	}
+}
+
+func TestServerEnvGroups(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := newTestClient(t, server)
+
+	service, err := client.CreateService(api.ServiceData{Service: render.Service{Name: "app", OwnerID: "usr-1", Type: "web_service"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	value := "1"
+	created, err := client.CreateEnvGroup(api.EnvGroupData{
+		Name:        "shared",
+		OwnerID:     "usr-1",
+		EnvVars:     []api.EnvVarInput{{Key: "A", EnvVarValue: api.EnvVarValue{Value: &value}}},
+		SecretFiles: []api.SecretFile{{Name: "secret.txt", Content: "s3cr3t"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(created.EnvVars) != 1 || len(created.SecretFiles) != 1 {
+		t.Errorf("unexpected environment group: %+v", created)
+	}
+
+	if err := client.LinkEnvGroupService(created.ID, service.ID); err != nil {
+		t.Fatal(err)
+	}
+	if ids := server.EnvGroupServiceIDs(created.ID); len(ids) != 1 || ids[0] != service.ID {
+		t.Errorf("expected the service to be linked, got %v", ids)
+	}
+	if err := client.DeleteService(service.ID); err != nil {
+		t.Fatal(err)
+	}
+	if ids := server.EnvGroupServiceIDs(created.ID); len(ids) != 0 {
+		t.Errorf("expected the service to be unlinked when deleted, got %v", ids)
+	}
+
+	if err := client.DeleteEnvGroup(created.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetEnvGroup(created.ID); !api.IsNotFound(err) {
+		t.Errorf("expected not found, got %v", err)
+	}
+}
+
+func TestServerNotFound(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/blueprints", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Bearer "+APIKey)
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusNotFound {
+		t.Errorf("expected status 404, got %d", res.StatusCode)
+	}
+}
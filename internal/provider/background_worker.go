@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sonlir/render-client-go"
+)
+
+var (
+	_ resource.Resource                   = &BackgroundWorker{}
+	_ resource.ResourceWithConfigure      = &BackgroundWorker{}
+	_ resource.ResourceWithImportState    = &BackgroundWorker{}
+	_ resource.ResourceWithUpgradeState   = &BackgroundWorker{}
+	_ resource.ResourceWithValidateConfig = &BackgroundWorker{}
+	_ resource.ResourceWithModifyPlan     = &BackgroundWorker{}
+)
+
+func NewBackgroundWorker() resource.Resource {
+	return &BackgroundWorker{serviceResource[BackgroundWorkerDetails]{conversion: backgroundWorkerConversion}}
+}
+
+type BackgroundWorker struct {
+	serviceResource[BackgroundWorkerDetails]
+}
+
+type BackgroundWorkerModel = ServiceResourceModel[BackgroundWorkerDetails]
+
+type BackgroundWorkerDetails struct {
+	Autoscaling                *Autoscaling              `tfsdk:"autoscaling"`
+	Disk                       *Disk                     `tfsdk:"disk"`
+	Env                        types.String              `tfsdk:"env"`
+	DockerDetails              *DockerDetails            `tfsdk:"docker_details"`
+	NativeEnvironmentDetails   *NativeEnvironmentDetails `tfsdk:"native_environment_details"`
+	NumInstances               types.Int64               `tfsdk:"num_instances"`
+	Plan                       types.String              `tfsdk:"plan"`
+	PullRequestPreviewsEnabled types.String              `tfsdk:"pull_request_previews_enabled"`
+	Region                     types.String              `tfsdk:"region"`
+	ParentServer               types.Object              `tfsdk:"parent_server"`
+}
+
+var backgroundWorkerConversion = serviceConversion[BackgroundWorkerDetails]{
+	serviceType:      "background_worker",
+	makeDetailsModel: makeBackgroundWorkerDetailsModel,
+	makeDetailsData:  makeBackgroundWorkerDetailsData,
+}
+
+func (r *BackgroundWorker) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = serviceResourceSchema(ctx, "Creates a new Render background worker owned by you or a team you belong to. Background workers run continuously and don't receive incoming network traffic, which makes them a good fit for queue consumers.", scalableServiceDetailsAttributes())
+}
+
+func makeBackgroundWorkerDetailsModel(current *BackgroundWorkerDetails, imported bool, serviceDetails render.ServiceDetails) *BackgroundWorkerDetails {
+	backgroundWorkerDetails := &BackgroundWorkerDetails{
+		Autoscaling:                makeAutoscalingModel(serviceDetails.Autoscaling),
+		Disk:                       makeServiceDiskModel(current.Disk, imported, serviceDetails.Disk),
+		Env:                        types.StringValue(serviceDetails.Env),
+		NumInstances:               types.Int64Value(serviceDetails.NumInstances),
+		Plan:                       types.StringValue(serviceDetails.Plan),
+		PullRequestPreviewsEnabled: types.StringValue(serviceDetails.PullRequestPreviewsEnabled),
+		Region:                     types.StringValue(serviceDetails.Region),
+		ParentServer:               parentServerValue(serviceDetails.ParentServer),
+	}
+	backgroundWorkerDetails.DockerDetails, backgroundWorkerDetails.NativeEnvironmentDetails = makeEnvSpecificDetailsResourceModel(current.DockerDetails, current.NativeEnvironmentDetails, imported, serviceDetails)
+	return backgroundWorkerDetails
+}
+
+func makeBackgroundWorkerDetailsData(backgroundWorkerDetails *BackgroundWorkerDetails) render.ServiceDetails {
+	return render.ServiceDetails{
+		Autoscaling: makeAutoscalingData(backgroundWorkerDetails.Autoscaling),
+		Disk:        makeServiceDiskData(backgroundWorkerDetails.Disk),
+		Env:         backgroundWorkerDetails.Env.ValueString(),
+		// ValidateConfig makes sure that only the block matching the runtime is set.
+		EnvSpecificDetails:         makeEnvSpecificDetailsData(backgroundWorkerDetails.Env, backgroundWorkerDetails.DockerDetails, backgroundWorkerDetails.NativeEnvironmentDetails),
+		NumInstances:               backgroundWorkerDetails.NumInstances.ValueInt64(),
+		Plan:                       backgroundWorkerDetails.Plan.ValueString(),
+		PullRequestPreviewsEnabled: backgroundWorkerDetails.PullRequestPreviewsEnabled.ValueString(),
+		Region:                     backgroundWorkerDetails.Region.ValueString(),
+	}
+}
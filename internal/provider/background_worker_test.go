@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/sonlir/render-client-go"
+)
+
+func TestBackgroundWorkerResource(t *testing.T) {
+	server, providerConfig := newTestServer(t)
+
+	var id string
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckServiceDestroy(server, "render_background_worker"),
+		Steps: []resource.TestStep{
+			// Only the block matching the runtime can be set
+			{
+				Config:      providerConfig + testBackgroundWorkerConfig("my-worker", "docker", "starter", "s3cr3t"),
+				ExpectError: regexp.MustCompile("Invalid Environment Details"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + testBackgroundWorkerConfig("my-worker", "node", "starter", "s3cr3t"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("render_background_worker.test", "id", func(value string) error {
+						id = value
+						return nil
+					}),
+					resource.TestCheckResourceAttr("render_background_worker.test", "name", "my-worker"),
+					resource.TestCheckResourceAttr("render_background_worker.test", "type", "background_worker"),
+					resource.TestCheckResourceAttr("render_background_worker.test", "service_details.plan", "starter"),
+					resource.TestCheckResourceAttr("render_background_worker.test", "service_details.native_environment_details.start_command", "node worker.js"),
+					resource.TestCheckNoResourceAttr("render_background_worker.test", "service_details.docker_details"),
+					resource.TestCheckResourceAttr("render_background_worker.test", "environment_variables.QUEUE.value", "default"),
+					resource.TestCheckResourceAttr("render_background_worker.test", "secret_files.#", "1"),
+					resource.TestCheckResourceAttr("render_background_worker.test", "secret_files.0.content", "s3cr3t"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "render_background_worker.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testBackgroundWorkerConfig("my-worker-renamed", "node", "standard", "rotated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("render_background_worker.test", "id", &id),
+					resource.TestCheckResourceAttr("render_background_worker.test", "name", "my-worker-renamed"),
+					resource.TestCheckResourceAttr("render_background_worker.test", "service_details.plan", "standard"),
+					resource.TestCheckResourceAttr("render_background_worker.test", "secret_files.0.content", "rotated"),
+					testCheckService(server, "render_background_worker.test", func(service render.Service) error {
+						if service.ServiceDetails.Plan != "standard" {
+							return fmt.Errorf("background worker was not updated: %+v", service.ServiceDetails)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestBackgroundWorkerDataSource(t *testing.T) {
+	_, providerConfig := newTestServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testBackgroundWorkerConfig("my-worker", "node", "starter", "s3cr3t") + `
+data "render_background_worker" "test" {
+  id = render_background_worker.test.id
+}
+
+data "render_background_workers" "test" {
+  name = render_background_worker.test.name
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.render_background_worker.test", "id", "render_background_worker.test", "id"),
+					resource.TestCheckResourceAttr("data.render_background_worker.test", "name", "my-worker"),
+					resource.TestCheckResourceAttr("data.render_background_worker.test", "type", "background_worker"),
+					resource.TestCheckResourceAttr("data.render_background_worker.test", "service_details.native_environment_details.build_command", "yarn"),
+					resource.TestCheckResourceAttr("data.render_background_worker.test", "environment_variables.%", "1"),
+					resource.TestCheckResourceAttr("data.render_background_workers.test", "background_workers.#", "1"),
+					resource.TestCheckResourceAttrPair("data.render_background_workers.test", "background_workers.0.id", "render_background_worker.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+// testBackgroundWorkerConfig returns a background worker using the native
+// runtime details, which are not valid when env is docker.
+func testBackgroundWorkerConfig(name, env, plan, secret string) string {
+	return fmt.Sprintf(`
+resource "render_background_worker" "test" {
+  name     = %q
+  owner_id = %q
+  repo     = "https://github.com/render-examples/express-hello-world"
+
+  service_details = {
+    env           = %q
+    plan          = %q
+    num_instances = 1
+    native_environment_details = {
+      build_command = "yarn"
+      start_command = "node worker.js"
+    }
+  }
+
+  environment_variables = {
+    QUEUE = { value = "default" }
+  }
+
+  secret_files = [
+    { name = "secret.txt", content = %q },
+  ]
+}
+`, name, testOwnerID, env, plan, secret)
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sonlir/render-client-go"
+)
+
+var (
+	_ resource.Resource                   = &CronJob{}
+	_ resource.ResourceWithConfigure      = &CronJob{}
+	_ resource.ResourceWithImportState    = &CronJob{}
+	_ resource.ResourceWithUpgradeState   = &CronJob{}
+	_ resource.ResourceWithValidateConfig = &CronJob{}
+	_ resource.ResourceWithModifyPlan     = &CronJob{}
+)
+
+func NewCronJob() resource.Resource {
+	return &CronJob{serviceResource[CronJobDetails]{conversion: cronJobConversion}}
+}
+
+type CronJob struct {
+	serviceResource[CronJobDetails]
+}
+
+type CronJobModel = ServiceResourceModel[CronJobDetails]
+
+type CronJobDetails struct {
+	Env                      types.String              `tfsdk:"env"`
+	DockerDetails            *DockerDetails            `tfsdk:"docker_details"`
+	NativeEnvironmentDetails *NativeEnvironmentDetails `tfsdk:"native_environment_details"`
+	LastSuccessfulRunAt      types.String              `tfsdk:"last_successful_run_at"`
+	Plan                     types.String              `tfsdk:"plan"`
+	Region                   types.String              `tfsdk:"region"`
+	Schedule                 types.String              `tfsdk:"schedule"`
+}
+
+var cronJobConversion = serviceConversion[CronJobDetails]{
+	serviceType:      "cron_job",
+	makeDetailsModel: makeCronJobDetailsModel,
+	makeDetailsData:  makeCronJobDetailsData,
+}
+
+func (r *CronJob) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = serviceResourceSchema(ctx, "Creates a new Render cron job owned by you or a team you belong to. Cron jobs run a command on a schedule and exit when it completes.", map[string]schema.Attribute{
+		"schedule": schema.StringAttribute{
+			MarkdownDescription: "The cron expression the job runs on, e.g. `0 * * * *`. Schedules are evaluated in UTC.",
+			Required:            true,
+			Validators:          []validator.String{cronScheduleValidator{}},
+		},
+		"last_successful_run_at": schema.StringAttribute{
+			MarkdownDescription: "The date and time of the last successful run of the job",
+			Computed:            true,
+		},
+	})
+}
+
+func makeCronJobDetailsModel(current *CronJobDetails, imported bool, serviceDetails render.ServiceDetails) *CronJobDetails {
+	cronJobDetails := &CronJobDetails{
+		Env:                 types.StringValue(serviceDetails.Env),
+		LastSuccessfulRunAt: types.StringValue(serviceDetails.LastSuccessfulRunAt),
+		Plan:                types.StringValue(serviceDetails.Plan),
+		Region:              types.StringValue(serviceDetails.Region),
+		Schedule:            types.StringValue(serviceDetails.Schedule),
+	}
+	cronJobDetails.DockerDetails, cronJobDetails.NativeEnvironmentDetails = makeEnvSpecificDetailsResourceModel(current.DockerDetails, current.NativeEnvironmentDetails, imported, serviceDetails)
+	return cronJobDetails
+}
+
+func makeCronJobDetailsData(cronJobDetails *CronJobDetails) render.ServiceDetails {
+	return render.ServiceDetails{
+		Env: cronJobDetails.Env.ValueString(),
+		// ValidateConfig makes sure that only the block matching the runtime is set.
+		EnvSpecificDetails: makeEnvSpecificDetailsData(cronJobDetails.Env, cronJobDetails.DockerDetails, cronJobDetails.NativeEnvironmentDetails),
+		Plan:               cronJobDetails.Plan.ValueString(),
+		Region:             cronJobDetails.Region.ValueString(),
+		Schedule:           cronJobDetails.Schedule.ValueString(),
+	}
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/sonlir/render-client-go"
+)
+
+func TestCronJobResource(t *testing.T) {
+	server, providerConfig := newTestServer(t)
+
+	var id string
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckServiceDestroy(server, "render_cron_job"),
+		Steps: []resource.TestStep{
+			// Only the block matching the runtime can be set
+			{
+				Config:      providerConfig + testCronJobConfig("my-job", "docker", "0 * * * *", "s3cr3t"),
+				ExpectError: regexp.MustCompile("Invalid Environment Details"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + testCronJobConfig("my-job", "node", "0 * * * *", "s3cr3t"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("render_cron_job.test", "id", func(value string) error {
+						id = value
+						return nil
+					}),
+					resource.TestCheckResourceAttr("render_cron_job.test", "name", "my-job"),
+					resource.TestCheckResourceAttr("render_cron_job.test", "type", "cron_job"),
+					resource.TestCheckResourceAttr("render_cron_job.test", "service_details.schedule", "0 * * * *"),
+					resource.TestCheckResourceAttr("render_cron_job.test", "service_details.native_environment_details.start_command", "node job.js"),
+					resource.TestCheckNoResourceAttr("render_cron_job.test", "service_details.docker_details"),
+					resource.TestCheckResourceAttr("render_cron_job.test", "environment_variables.TASK.value", "cleanup"),
+					resource.TestCheckResourceAttr("render_cron_job.test", "secret_files.#", "1"),
+					resource.TestCheckResourceAttr("render_cron_job.test", "secret_files.0.content", "s3cr3t"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "render_cron_job.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testCronJobConfig("my-job-renamed", "node", "30 2 * * *", "rotated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("render_cron_job.test", "id", &id),
+					resource.TestCheckResourceAttr("render_cron_job.test", "name", "my-job-renamed"),
+					resource.TestCheckResourceAttr("render_cron_job.test", "service_details.schedule", "30 2 * * *"),
+					resource.TestCheckResourceAttr("render_cron_job.test", "secret_files.0.content", "rotated"),
+					testCheckService(server, "render_cron_job.test", func(service render.Service) error {
+						if service.ServiceDetails.Schedule != "30 2 * * *" {
+							return fmt.Errorf("cron job was not updated: %+v", service.ServiceDetails)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+// testCronJobConfig returns a cron job using the native runtime details,
+// which are not valid when env is docker.
+func testCronJobConfig(name, env, schedule, secret string) string {
+	return fmt.Sprintf(`
+resource "render_cron_job" "test" {
+  name     = %q
+  owner_id = %q
+  repo     = "https://github.com/render-examples/express-hello-world"
+
+  service_details = {
+    env      = %q
+    schedule = %q
+    native_environment_details = {
+      build_command = "yarn"
+      start_command = "node job.js"
+    }
+  }
+
+  environment_variables = {
+    TASK = { value = "cleanup" }
+  }
+
+  secret_files = [
+    { name = "secret.txt", content = %q },
+  ]
+}
+`, name, testOwnerID, env, schedule, secret)
+}
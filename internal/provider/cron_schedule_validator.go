@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = cronScheduleValidator{}
+
+// cronScheduleValidator checks that a string is a standard five field cron
+// expression (minute, hour, day of month, month, day of week).
+type cronScheduleValidator struct{}
+
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names []string
+}
+
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}},
+	{name: "day of week", min: 0, max: 7, names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}},
+}
+
+func (v cronScheduleValidator) Description(_ context.Context) string {
+	return "value must be a cron expression with five fields: minute, hour, day of month, month and day of week"
+}
+
+func (v cronScheduleValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cronScheduleValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := validateCronSchedule(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid cron schedule",
+			fmt.Sprintf("The schedule %q is not a valid cron expression: %s.", req.ConfigValue.ValueString(), err),
+		)
+	}
+}
+
+func validateCronSchedule(schedule string) error {
+	fields := strings.Fields(schedule)
+	if len(fields) != len(cronFields) {
+		return fmt.Errorf("expected %d fields, got %d", len(cronFields), len(fields))
+	}
+
+	for i, field := range fields {
+		for _, part := range strings.Split(field, ",") {
+			if err := validateCronPart(part, cronFields[i]); err != nil {
+				return fmt.Errorf("%s field %q: %w", cronFields[i].name, field, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateCronPart(part string, field cronField) error {
+	rangePart, step, hasStep := strings.Cut(part, "/")
+	if hasStep {
+		n, err := strconv.Atoi(step)
+		if err != nil || n < 1 {
+			return fmt.Errorf("invalid step %q", step)
+		}
+	}
+
+	if rangePart == "*" {
+		return nil
+	}
+
+	low, high, isRange := strings.Cut(rangePart, "-")
+	lowValue, err := parseCronValue(low, field)
+	if err != nil {
+		return err
+	}
+	if !isRange {
+		return nil
+	}
+
+	highValue, err := parseCronValue(high, field)
+	if err != nil {
+		return err
+	}
+	if lowValue > highValue {
+		return fmt.Errorf("invalid range %q", rangePart)
+	}
+
+	return nil
+}
+
+func parseCronValue(value string, field cronField) (int, error) {
+	for i, name := range field.names {
+		if strings.EqualFold(value, name) {
+			return field.min + i, nil
+		}
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", value)
+	}
+	if n < field.min || n > field.max {
+		return 0, fmt.Errorf("value %d out of range %d-%d", n, field.min, field.max)
+	}
+
+	return n, nil
+}
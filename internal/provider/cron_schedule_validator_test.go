@@ -0,0 +1,37 @@
+package provider
+
+import "testing"
+
+func TestValidateCronSchedule(t *testing.T) {
+	tests := map[string]bool{
+		"* * * * *":          true,
+		"0 * * * *":          true,
+		"*/15 9-17 * * 1-5":  true,
+		"0 0 1,15 * *":       true,
+		"30 2 * JAN-MAR sun": true,
+		"0 0 * * 7":          true,
+		"0-30/10 * * * *":    true,
+		"":                   false,
+		"* * * *":            false,
+		"* * * * * *":        false,
+		"60 * * * *":         false,
+		"* 24 * * *":         false,
+		"* * 0 * *":          false,
+		"* * * 13 *":         false,
+		"* * * * 8":          false,
+		"*/0 * * * *":        false,
+		"5-1 * * * *":        false,
+		"@hourly":            false,
+		"a * * * *":          false,
+	}
+
+	for schedule, valid := range tests {
+		err := validateCronSchedule(schedule)
+		if valid && err != nil {
+			t.Errorf("expected %q to be valid, got: %s", schedule, err)
+		}
+		if !valid && err == nil {
+			t.Errorf("expected %q to be invalid", schedule)
+		}
+	}
+}
@@ -0,0 +1,425 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sonlir/render-client-go"
+
+	"terraform-provider-render/internal/api"
+)
+
+var (
+	_ resource.Resource                = &CustomDomain{}
+	_ resource.ResourceWithConfigure   = &CustomDomain{}
+	_ resource.ResourceWithImportState = &CustomDomain{}
+)
+
+const (
+	customDomainVerified            = "verified"
+	customDomainVerificationTimeout = 10 * time.Minute
+	customDomainVerificationPoll    = 10 * time.Second
+)
+
+func NewCustomDomain() resource.Resource {
+	return &CustomDomain{}
+}
+
+type CustomDomain struct {
+	client *api.Client
+}
+
+type CustomDomainModel struct {
+	ID                  types.String   `tfsdk:"id"`
+	ServiceID           types.String   `tfsdk:"service_id"`
+	Name                types.String   `tfsdk:"name"`
+	DomainType          types.String   `tfsdk:"domain_type"`
+	PublicSuffix        types.String   `tfsdk:"public_suffix"`
+	RedirectForName     types.String   `tfsdk:"redirect_for_name"`
+	VerificationStatus  types.String   `tfsdk:"verification_status"`
+	DNSRecords          types.List     `tfsdk:"dns_records"`
+	CreatedAt           types.String   `tfsdk:"created_at"`
+	WaitForVerification types.Bool     `tfsdk:"wait_for_verification"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+}
+
+var dnsRecordType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"type":  types.StringType,
+	"name":  types.StringType,
+	"value": types.StringType,
+}}
+
+func (r *CustomDomain) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_domain"
+}
+
+func (r *CustomDomain) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Adds a custom domain to a Render web service or static site.\n~> **Note:** When adding an apex domain such as `example.com`, Render also adds the `www` subdomain as a redirect. Its DNS records are included in `dns_records`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the custom domain",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the service the domain points to",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The domain name, e.g. `example.com` or `app.example.com`",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"domain_type": schema.StringAttribute{
+				MarkdownDescription: "The type of the domain, either `apex` or `subdomain`",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"public_suffix": schema.StringAttribute{
+				MarkdownDescription: "The public suffix of the domain, e.g. `com`",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"redirect_for_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the domain this domain redirects to, if any",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"verification_status": schema.StringAttribute{
+				MarkdownDescription: "The verification status of the domain, either `verified` or `unverified`",
+				Computed:            true,
+			},
+			"dns_records": schema.ListNestedAttribute{
+				MarkdownDescription: "The DNS records to create with your DNS provider for the domain to be verified",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The record type, either `ALIAS` for apex domains or `CNAME`. Use an `ANAME` or flattened `CNAME` record if your DNS provider has no `ALIAS` records.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The fully qualified name of the record",
+							Computed:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "The value of the record",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The date and time the domain was added",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"wait_for_verification": schema.BoolAttribute{
+				MarkdownDescription: "Whether to wait on create until Render has verified the domain. The DNS records must already exist. If the domain isn't verified in time, the apply warns and Render keeps checking the records. Default: `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create:            true,
+				CreateDescription: "How long to wait for the domain to be verified when `wait_for_verification` is set. Default: `10m`.",
+			}),
+		},
+	}
+}
+
+func (r *CustomDomain) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*render.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = api.NewClient(client)
+}
+
+func (r *CustomDomain) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan CustomDomainModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceID := plan.ServiceID.ValueString()
+	name := plan.Name.ValueString()
+
+	_, err := r.client.CreateCustomDomain(serviceID, render.CustomDomain{Name: name})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Render custom domain",
+			"Could not create custom domain, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	customDomain, err := r.client.GetCustomDomain(serviceID, name)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Render custom domain",
+			"Could not get custom domain: "+name+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.DNSRecords, diags = r.dnsRecords(serviceID, customDomain)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.WaitForVerification.ValueBool() {
+		createTimeout, diags := plan.Timeouts.Create(ctx, customDomainVerificationTimeout)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// The domain exists either way, so failing here would only taint it.
+		customDomain, err = r.waitForVerification(ctx, serviceID, customDomain, createTimeout)
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"Render custom domain not verified",
+				"Custom domain "+name+" was created but could not be verified yet: "+err.Error()+". Render keeps checking its DNS records.",
+			)
+		}
+	}
+
+	makeCustomDomainModel(&plan, customDomain)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *CustomDomain) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state CustomDomainModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	customDomain, err := r.client.GetCustomDomain(state.ServiceID.ValueString(), state.ID.ValueString())
+	if api.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not get Render custom domain: "+state.ID.ValueString(),
+			err.Error(),
+		)
+		return
+	}
+
+	makeCustomDomainModel(&state, customDomain)
+
+	// The records only change with the service, so they are looked up once
+	// after an import rather than on every read.
+	if state.DNSRecords.IsNull() {
+		state.DNSRecords, diags = r.dnsRecords(state.ServiceID.ValueString(), customDomain)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update only stores the new wait_for_verification and timeouts values,
+// every other argument requires replacement.
+func (r *CustomDomain) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state CustomDomainModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.WaitForVerification = plan.WaitForVerification
+	state.Timeouts = plan.Timeouts
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *CustomDomain) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state CustomDomainModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteCustomDomain(state.ServiceID.ValueString(), state.ID.ValueString())
+	if err != nil && !api.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting Render custom domain",
+			"Could not delete custom domain ID: "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *CustomDomain) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serviceID, id, ok := strings.Cut(req.ID, "/")
+	if !ok || serviceID == "" || id == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <service_id>/<custom_domain_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), serviceID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_verification"), false)...)
+}
+
+// waitForVerification asks Render to verify the domain until it succeeds or
+// the timeout expires. It returns the last domain it read.
+func (r *CustomDomain) waitForVerification(ctx context.Context, serviceID string, customDomain *render.CustomDomain, timeout time.Duration) (*render.CustomDomain, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	id := *customDomain.ID
+	for {
+		if customDomain.VerificationStatus != nil && *customDomain.VerificationStatus == customDomainVerified {
+			return customDomain, nil
+		}
+
+		// Verification fails until the DNS records have propagated.
+		_ = r.client.VerifyCustomDomain(serviceID, id)
+
+		select {
+		case <-ctx.Done():
+			return customDomain, fmt.Errorf("timed out after %s, verification status: %s", timeout, types.StringPointerValue(customDomain.VerificationStatus).ValueString())
+		case <-time.After(customDomainVerificationPoll):
+		}
+
+		latest, err := r.client.GetCustomDomain(serviceID, id)
+		if err != nil {
+			return customDomain, err
+		}
+		customDomain = latest
+	}
+}
+
+// dnsRecords returns the records pointing customDomain, and the redirect
+// domain Render adds for apex domains, at the service.
+func (r *CustomDomain) dnsRecords(serviceID string, customDomain *render.CustomDomain) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	service, err := r.client.GetService(serviceID)
+	if err != nil {
+		diags.AddError(
+			"Could not get Render service: "+serviceID,
+			err.Error(),
+		)
+		return types.ListNull(dnsRecordType), diags
+	}
+
+	customDomains, err := r.client.GetCustomDomains(serviceID)
+	if err != nil {
+		diags.AddError(
+			"Could not get Render custom domains for service: "+serviceID,
+			err.Error(),
+		)
+		return types.ListNull(dnsRecordType), diags
+	}
+
+	return makeDNSRecords(customDomain, service, customDomains), diags
+}
+
+func makeCustomDomainModel(state *CustomDomainModel, customDomain *render.CustomDomain) {
+	state.ID = types.StringPointerValue(customDomain.ID)
+	state.Name = types.StringValue(customDomain.Name)
+	if customDomain.Server != nil && customDomain.Server.ID != nil {
+		state.ServiceID = types.StringValue(*customDomain.Server.ID)
+	}
+	state.DomainType = types.StringPointerValue(customDomain.DomainType)
+	state.PublicSuffix = types.StringPointerValue(customDomain.PublicSuffix)
+	state.RedirectForName = types.StringPointerValue(customDomain.RedirectForName)
+	state.VerificationStatus = types.StringPointerValue(customDomain.VerificationStatus)
+	state.CreatedAt = types.StringPointerValue(customDomain.CreatedAt)
+}
+
+func makeDNSRecords(customDomain *render.CustomDomain, service *render.Service, customDomains []render.CustomDomain) types.List {
+	target := service.Slug + ".onrender.com"
+	if service.ServiceDetails.URL != "" {
+		if u, err := url.Parse(service.ServiceDetails.URL); err == nil && u.Host != "" {
+			target = u.Host
+		}
+	}
+
+	dnsRecords := []attr.Value{makeDNSRecord(customDomain, target)}
+	for _, other := range customDomains {
+		if other.RedirectForName != nil && *other.RedirectForName == customDomain.Name && other.Name != customDomain.Name {
+			dnsRecords = append(dnsRecords, makeDNSRecord(&other, target))
+		}
+	}
+	return types.ListValueMust(dnsRecordType, dnsRecords)
+}
+
+// makeDNSRecord returns the dnsRecordType object for the record pointing
+// customDomain at target. Apex domains can't have a CNAME record, so they
+// get an ALIAS record instead.
+func makeDNSRecord(customDomain *render.CustomDomain, target string) types.Object {
+	recordType := "CNAME"
+	if customDomain.DomainType != nil && *customDomain.DomainType == "apex" {
+		recordType = "ALIAS"
+	}
+
+	return types.ObjectValueMust(dnsRecordType.AttrTypes, map[string]attr.Value{
+		"type":  types.StringValue(recordType),
+		"name":  types.StringValue(customDomain.Name),
+		"value": types.StringValue(target),
+	})
+}
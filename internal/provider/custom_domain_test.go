@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestCustomDomainResource(t *testing.T) {
+	_, providerConfig := newTestServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing: an apex domain comes with its www
+			// redirect
+			{
+				Config: providerConfig + testWebServiceConfig("my-app", "starter", 1) + testCustomDomainConfig("example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("render_custom_domain.test", "id"),
+					resource.TestCheckResourceAttrPair("render_custom_domain.test", "service_id", "render_web_service.test", "id"),
+					resource.TestCheckResourceAttr("render_custom_domain.test", "domain_type", "apex"),
+					resource.TestCheckResourceAttr("render_custom_domain.test", "public_suffix", "com"),
+					resource.TestCheckResourceAttr("render_custom_domain.test", "verification_status", "unverified"),
+					resource.TestCheckResourceAttr("render_custom_domain.test", "dns_records.#", "2"),
+					resource.TestCheckResourceAttr("render_custom_domain.test", "dns_records.0.type", "ALIAS"),
+					resource.TestCheckResourceAttr("render_custom_domain.test", "dns_records.0.name", "example.com"),
+					resource.TestCheckResourceAttrWith("render_custom_domain.test", "dns_records.0.value", testCheckOnRenderHost),
+					resource.TestCheckResourceAttr("render_custom_domain.test", "dns_records.1.type", "CNAME"),
+					resource.TestCheckResourceAttr("render_custom_domain.test", "dns_records.1.name", "www.example.com"),
+					resource.TestCheckResourceAttrWith("render_custom_domain.test", "dns_records.1.value", testCheckOnRenderHost),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: "render_custom_domain.test",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					customDomain := s.RootModule().Resources["render_custom_domain.test"].Primary
+					return customDomain.Attributes["service_id"] + "/" + customDomain.ID, nil
+				},
+				ImportStateVerify: true,
+			},
+			// Changing the name replaces the domain
+			{
+				Config: providerConfig + testWebServiceConfig("my-app", "starter", 1) + testCustomDomainConfig("app.example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("render_custom_domain.test", "domain_type", "subdomain"),
+					resource.TestCheckResourceAttr("render_custom_domain.test", "dns_records.#", "1"),
+					resource.TestCheckResourceAttr("render_custom_domain.test", "dns_records.0.type", "CNAME"),
+					resource.TestCheckResourceAttrWith("render_custom_domain.test", "dns_records.0.value", testCheckOnRenderHost),
+				),
+			},
+			// A domain that isn't verified in time is kept without an error
+			{
+				Config: providerConfig + testWebServiceConfig("my-app", "starter", 1) + `
+resource "render_custom_domain" "test" {
+  service_id            = render_web_service.test.id
+  name                  = "api.example.com"
+  wait_for_verification = true
+
+  timeouts = {
+    create = "1ms"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("render_custom_domain.test", "name", "api.example.com"),
+					resource.TestCheckResourceAttr("render_custom_domain.test", "verification_status", "unverified"),
+					resource.TestCheckResourceAttr("render_custom_domain.test", "dns_records.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckOnRenderHost(value string) error {
+	if !strings.HasSuffix(value, ".onrender.com") {
+		return fmt.Errorf("expected a record pointing at an onrender.com host, got %s", value)
+	}
+	return nil
+}
+
+func testCustomDomainConfig(name string) string {
+	return fmt.Sprintf(`
+resource "render_custom_domain" "test" {
+  service_id = render_web_service.test.id
+  name       = %q
+}
+`, name)
+}
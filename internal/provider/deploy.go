@@ -0,0 +1,353 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sonlir/render-client-go"
+
+	"terraform-provider-render/internal/api"
+)
+
+var (
+	_ resource.Resource                = &Deploy{}
+	_ resource.ResourceWithConfigure   = &Deploy{}
+	_ resource.ResourceWithImportState = &Deploy{}
+)
+
+const (
+	deployLive    = "live"
+	deployTimeout = 30 * time.Minute
+)
+
+// deployPollInterval is how often the status of a deploy is checked while
+// waiting for it to finish.
+var deployPollInterval = 10 * time.Second
+
+func NewDeploy() resource.Resource {
+	return &Deploy{}
+}
+
+type Deploy struct {
+	client *api.Client
+}
+
+type DeployModel struct {
+	ID                types.String   `tfsdk:"id"`
+	ServiceID         types.String   `tfsdk:"service_id"`
+	Triggers          types.Map      `tfsdk:"triggers"`
+	ClearCache        types.Bool     `tfsdk:"clear_cache"`
+	CommitID          types.String   `tfsdk:"commit_id"`
+	ImageURL          types.String   `tfsdk:"image_url"`
+	WaitForCompletion types.Bool     `tfsdk:"wait_for_completion"`
+	Status            types.String   `tfsdk:"status"`
+	CreatedAt         types.String   `tfsdk:"created_at"`
+	FinishedAt        types.String   `tfsdk:"finished_at"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *Deploy) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deploy"
+}
+
+func (r *Deploy) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Triggers a deploy of a Render service. A new deploy is triggered whenever `triggers` changes.\n~> **Note:** Destroying this resource does not roll back the deploy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the deploy",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the service to deploy",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that trigger a new deploy when changed, e.g. an image digest or a git SHA",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers:       []planmodifier.Map{mapplanmodifier.RequiresReplace()},
+			},
+			"clear_cache": schema.BoolAttribute{
+				MarkdownDescription: "Whether to clear the build cache before deploying. Default: `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"commit_id": schema.StringAttribute{
+				MarkdownDescription: "The git commit to deploy. Defaults to the latest commit of the service's branch.",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"image_url": schema.StringAttribute{
+				MarkdownDescription: "The image to deploy, for services deployed from a prebuilt image. Defaults to the image configured on the service.",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				MarkdownDescription: "Whether to wait until the deploy finishes and fail if it does not go live. Default: `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the deploy, e.g. `build_in_progress`, `live` or `build_failed`",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The date and time the deploy was triggered",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"finished_at": schema.StringAttribute{
+				MarkdownDescription: "The date and time the deploy finished",
+				Computed:            true,
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create:            true,
+				CreateDescription: "How long to wait for the deploy to finish when `wait_for_completion` is set. Default: `30m`.",
+			}),
+		},
+	}
+}
+
+func (r *Deploy) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*render.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = api.NewClient(client)
+}
+
+func (r *Deploy) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DeployModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceID := plan.ServiceID.ValueString()
+
+	deploy, err := r.client.CreateDeploy(serviceID, makeDeployData(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Render deploy",
+			"Could not deploy service ID: "+serviceID+": "+err.Error(),
+		)
+		return
+	}
+
+	if plan.WaitForCompletion.ValueBool() {
+		createTimeout, diags := plan.Timeouts.Create(ctx, deployTimeout)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		deploy, err = waitForDeploy(ctx, r.client, serviceID, deploy, createTimeout)
+		if err == nil && deploy.Status != deployLive {
+			err = fmt.Errorf("deploy %s finished with status: %s", deploy.ID, deploy.Status)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error deploying Render service",
+				"Deploy of service ID: "+serviceID+" did not go live: "+err.Error(),
+			)
+			// Keep the deploy in state, so that it is replaced on the next apply.
+			makeDeployModel(&plan, deploy)
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+			return
+		}
+	}
+
+	makeDeployModel(&plan, deploy)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *Deploy) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DeployModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deploy, err := r.client.GetDeploy(state.ServiceID.ValueString(), state.ID.ValueString())
+	if api.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not get Render deploy: "+state.ID.ValueString(),
+			err.Error(),
+		)
+		return
+	}
+
+	makeDeployModel(&state, deploy)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update only stores the arguments that do not trigger a new deploy.
+func (r *Deploy) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state DeployModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ClearCache = plan.ClearCache
+	state.WaitForCompletion = plan.WaitForCompletion
+	state.Timeouts = plan.Timeouts
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete only removes the deploy from the state, a deploy can't be undone.
+func (r *Deploy) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+func (r *Deploy) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serviceID, id, ok := strings.Cut(req.ID, "/")
+	if !ok || serviceID == "" || id == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <service_id>/<deploy_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), serviceID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("clear_cache"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_completion"), false)...)
+}
+
+// waitForDeploy polls a deploy until it reaches a terminal status or the
+// timeout expires. It returns the last deploy it read.
+func waitForDeploy(ctx context.Context, client *api.Client, serviceID string, deploy *api.Deploy, timeout time.Duration) (*api.Deploy, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for !api.DeployTerminalStatuses[deploy.Status] {
+		select {
+		case <-ctx.Done():
+			return deploy, fmt.Errorf("timed out after %s waiting for deploy %s, last status: %s", timeout, deploy.ID, deploy.Status)
+		case <-time.After(deployPollInterval):
+		}
+
+		latest, err := client.GetDeploy(serviceID, deploy.ID)
+		if err != nil {
+			return deploy, fmt.Errorf("could not get deploy %s: %w", deploy.ID, err)
+		}
+		deploy = latest
+	}
+
+	return deploy, nil
+}
+
+// waitForLatestDeploy waits for a deploy of a service newer than previousID
+// to finish. Render starts the deploy shortly after a service is created or
+// updated, so the deploys are polled until it shows up or the timeout expires.
+func waitForLatestDeploy(ctx context.Context, client *api.Client, serviceID, previousID string, timeout time.Duration) (*api.Deploy, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		deploys, err := client.GetDeploys(serviceID, &api.GetDeploysArgs{Limit: 1})
+		if err != nil {
+			return nil, fmt.Errorf("could not get deploys: %w", err)
+		}
+		// Deploys are listed newest first.
+		if deploys != nil && deploys[0].ID != previousID {
+			return waitForDeploy(ctx, client, serviceID, &deploys[0], timeout)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timed out after %s waiting for a new deploy to start", timeout)
+		case <-time.After(deployPollInterval):
+		}
+	}
+}
+
+// latestDeployID returns the ID of the latest deploy of a service, or an
+// empty string if it has none.
+func latestDeployID(client *api.Client, serviceID string) (string, error) {
+	deploys, err := client.GetDeploys(serviceID, &api.GetDeploysArgs{Limit: 1})
+	if err != nil {
+		return "", err
+	}
+	if deploys == nil {
+		return "", nil
+	}
+
+	return deploys[0].ID, nil
+}
+
+func makeDeployModel(state *DeployModel, deploy *api.Deploy) {
+	state.ID = types.StringValue(deploy.ID)
+	state.Status = types.StringValue(deploy.Status)
+	state.CreatedAt = types.StringValue(deploy.CreatedAt)
+	state.FinishedAt = types.StringValue(deploy.FinishedAt)
+}
+
+func makeDeployData(plan *DeployModel) api.DeployData {
+	data := api.DeployData{
+		ClearCache: "do_not_clear",
+		CommitID:   plan.CommitID.ValueString(),
+		ImageURL:   plan.ImageURL.ValueString(),
+	}
+	if plan.ClearCache.ValueBool() {
+		data.ClearCache = "clear"
+	}
+
+	return data
+}
@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/sonlir/render-client-go"
+
+	"terraform-provider-render/internal/api"
+)
+
+func TestDeployResource(t *testing.T) {
+	server, providerConfig := newTestServer(t)
+
+	var id string
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testWebServiceConfig("my-app", "starter", 1) + testDeployConfig("v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("render_deploy.test", "id", func(value string) error {
+						id = value
+						return nil
+					}),
+					resource.TestCheckResourceAttrPair("render_deploy.test", "service_id", "render_web_service.test", "id"),
+					resource.TestCheckResourceAttr("render_deploy.test", "commit_id", "abc123"),
+					resource.TestCheckResourceAttr("render_deploy.test", "status", "live"),
+					resource.TestCheckResourceAttrSet("render_deploy.test", "finished_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: "render_deploy.test",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					deploy := s.RootModule().Resources["render_deploy.test"].Primary
+					return deploy.Attributes["service_id"] + "/" + deploy.ID, nil
+				},
+				ImportStateVerify: true,
+				// Only the configuration knows what triggered the deploy.
+				ImportStateVerifyIgnore: []string{"triggers", "commit_id", "wait_for_completion"},
+			},
+			// Changing the triggers starts a new deploy
+			{
+				Config: providerConfig + testWebServiceConfig("my-app", "starter", 1) + testDeployConfig("v2"),
+				Check: resource.TestCheckResourceAttrWith("render_deploy.test", "id", func(value string) error {
+					if value == id {
+						return fmt.Errorf("expected a new deploy, got %s", value)
+					}
+					return nil
+				}),
+			},
+			// A failed deploy is an error when waiting for completion
+			{
+				PreConfig: func() {
+					server.SetDeployStatus("build_failed")
+				},
+				Config:      providerConfig + testWebServiceConfig("my-app", "starter", 1) + testDeployConfig("v3"),
+				ExpectError: regexp.MustCompile("build_failed"),
+			},
+		},
+	})
+}
+
+func TestWaitForLatestDeploy(t *testing.T) {
+	defer func(interval time.Duration) { deployPollInterval = interval }(deployPollInterval)
+	deployPollInterval = time.Millisecond
+
+	tests := map[string]struct {
+		previousID string
+		// lists are the deploys listed by each poll, the last one repeats.
+		lists   [][]api.Deploy
+		wantID  string
+		wantErr string
+	}{
+		"new deploy": {
+			previousID: "dep-1",
+			lists: [][]api.Deploy{
+				{{ID: "dep-1", Status: "live"}},
+				{{ID: "dep-1", Status: "live"}},
+				{{ID: "dep-2", Status: "build_in_progress"}},
+			},
+			wantID: "dep-2",
+		},
+		"no deploy yet": {
+			lists: [][]api.Deploy{
+				{},
+				{{ID: "dep-1", Status: "build_in_progress"}},
+			},
+			wantID: "dep-1",
+		},
+		"no new deploy": {
+			previousID: "dep-1",
+			lists: [][]api.Deploy{
+				{{ID: "dep-1", Status: "live"}},
+			},
+			wantErr: "waiting for a new deploy to start",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			polls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				// The deploy being waited for finishes when it is read.
+				if id, ok := strings.CutPrefix(r.URL.Path, "/services/srv-1/deploys/"); ok {
+					_ = json.NewEncoder(w).Encode(api.Deploy{ID: id, Status: "live"})
+					return
+				}
+				list := test.lists[min(polls, len(test.lists)-1)]
+				polls++
+				page := []api.Deploys{}
+				for _, deploy := range list {
+					page = append(page, api.Deploys{Deploy: deploy})
+				}
+				_ = json.NewEncoder(w).Encode(page)
+			}))
+			defer server.Close()
+
+			apiKey := "rnd_test"
+			client, err := render.NewClient(&apiKey, &server.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			deploy, err := waitForLatestDeploy(context.Background(), api.NewClient(client), "srv-1", test.previousID, 50*time.Millisecond)
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("expected an error containing %q, got: %v", test.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if deploy.ID != test.wantID || deploy.Status != deployLive {
+				t.Errorf("expected deploy %s to be live, got %s with status %s", test.wantID, deploy.ID, deploy.Status)
+			}
+		})
+	}
+}
+
+func testDeployConfig(version string) string {
+	return fmt.Sprintf(`
+resource "render_deploy" "test" {
+  service_id          = render_web_service.test.id
+  commit_id           = "abc123"
+  wait_for_completion = true
+
+  triggers = {
+    version = %q
+  }
+}
+`, version)
+}
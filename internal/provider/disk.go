@@ -0,0 +1,233 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sonlir/render-client-go"
+
+	"terraform-provider-render/internal/api"
+)
+
+var (
+	_ resource.Resource                = &DiskResource{}
+	_ resource.ResourceWithConfigure   = &DiskResource{}
+	_ resource.ResourceWithImportState = &DiskResource{}
+)
+
+func NewDisk() resource.Resource {
+	return &DiskResource{}
+}
+
+type DiskResource struct {
+	client *api.Client
+}
+
+type DiskModel struct {
+	ID        types.String `tfsdk:"id"`
+	ServiceID types.String `tfsdk:"service_id"`
+	Name      types.String `tfsdk:"name"`
+	MountPath types.String `tfsdk:"mount_path"`
+	SizeGB    types.Int64  `tfsdk:"size_gb"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+func (r *DiskResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_disk"
+}
+
+func (r *DiskResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Attaches a persistent disk to a Render service.\n~> **Note:** Do not also set `service_details.disk` on the service the disk is attached to.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the disk",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the service the disk is attached to. Changing this forces a new disk.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the disk",
+				Required:            true,
+			},
+			"mount_path": schema.StringAttribute{
+				MarkdownDescription: "The absolute path the disk is mounted at, e.g. `/var/data`",
+				Required:            true,
+			},
+			"size_gb": schema.Int64Attribute{
+				MarkdownDescription: "The size of the disk in GB. The disk is resized in place, but it can only grow.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.Int64{diskSizeGrowOnlyModifier{}},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The date and time the disk was created",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "The date and time the disk was last updated",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *DiskResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*render.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = api.NewClient(client)
+}
+
+func (r *DiskResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DiskModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	disk, err := r.client.CreateDisk(makeDiskData(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Render disk",
+			"Could not create disk, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	makeDiskModel(&plan, disk)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *DiskResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DiskModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	disk, err := r.client.GetDisk(state.ID.ValueString())
+	if api.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not get Render disk: "+state.ID.ValueString(),
+			err.Error(),
+		)
+		return
+	}
+
+	makeDiskModel(&state, disk)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *DiskResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state DiskModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+
+	disk, err := r.client.UpdateDisk(plan.ID.ValueString(), makeDiskData(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Render disk",
+			"Could not update disk ID: "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	makeDiskModel(&plan, disk)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *DiskResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DiskModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDisk(state.ID.ValueString())
+	if err != nil && !api.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting Render disk",
+			"Could not delete disk ID: "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *DiskResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func makeDiskModel(state *DiskModel, disk *api.Disk) {
+	state.ID = types.StringValue(disk.ID)
+	state.ServiceID = types.StringValue(disk.ServiceID)
+	state.Name = types.StringValue(disk.Name)
+	state.MountPath = types.StringValue(disk.MountPath)
+	state.SizeGB = types.Int64Value(disk.SizeGB)
+	state.CreatedAt = types.StringValue(disk.CreatedAt)
+	state.UpdatedAt = types.StringValue(disk.UpdatedAt)
+}
+
+func makeDiskData(plan *DiskModel) api.DiskData {
+	return api.DiskData{
+		MountPath: plan.MountPath.ValueString(),
+		Name:      plan.Name.ValueString(),
+		ServiceID: plan.ServiceID.ValueString(),
+		SizeGB:    plan.SizeGB.ValueInt64(),
+	}
+}
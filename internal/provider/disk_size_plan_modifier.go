@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+var _ planmodifier.Int64 = diskSizeGrowOnlyModifier{}
+
+// diskSizeGrowOnlyModifier rejects plans that shrink a disk. Render can only
+// grow disks, and replacing the disk instead would lose its data.
+type diskSizeGrowOnlyModifier struct{}
+
+func (m diskSizeGrowOnlyModifier) Description(_ context.Context) string {
+	return "the disk size can be increased but not decreased"
+}
+
+func (m diskSizeGrowOnlyModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m diskSizeGrowOnlyModifier) PlanModifyInt64(_ context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	if req.StateValue.IsNull() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	if req.PlanValue.ValueInt64() < req.StateValue.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Disk Size",
+			fmt.Sprintf("Disks can only grow. The size cannot be reduced from %d GB to %d GB.", req.StateValue.ValueInt64(), req.PlanValue.ValueInt64()),
+		)
+	}
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDiskSizeGrowOnlyModifier(t *testing.T) {
+	tests := map[string]struct {
+		state types.Int64
+		plan  types.Int64
+		valid bool
+	}{
+		"create":  {state: types.Int64Null(), plan: types.Int64Value(10), valid: true},
+		"grow":    {state: types.Int64Value(10), plan: types.Int64Value(20), valid: true},
+		"same":    {state: types.Int64Value(10), plan: types.Int64Value(10), valid: true},
+		"unknown": {state: types.Int64Value(10), plan: types.Int64Unknown(), valid: true},
+		"shrink":  {state: types.Int64Value(20), plan: types.Int64Value(10), valid: false},
+	}
+
+	for name, test := range tests {
+		req := planmodifier.Int64Request{
+			Path:       path.Root("size_gb"),
+			StateValue: test.state,
+			PlanValue:  test.plan,
+		}
+		resp := &planmodifier.Int64Response{PlanValue: test.plan}
+
+		diskSizeGrowOnlyModifier{}.PlanModifyInt64(context.Background(), req, resp)
+
+		if test.valid && resp.Diagnostics.HasError() {
+			t.Errorf("%s: unexpected error: %v", name, resp.Diagnostics)
+		}
+		if !test.valid && !resp.Diagnostics.HasError() {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sonlir/render-client-go"
+
+	"terraform-provider-render/internal/api"
+)
+
+var (
+	_ datasource.DataSource              = &DiskSnapshotsDataSource{}
+	_ datasource.DataSourceWithConfigure = &DiskSnapshotsDataSource{}
+)
+
+func NewDiskSnapshotsDataSource() datasource.DataSource {
+	return &DiskSnapshotsDataSource{}
+}
+
+type DiskSnapshotsDataSource struct {
+	client *api.Client
+}
+
+type DiskSnapshotsDataSourceModel struct {
+	DiskID    types.String                  `tfsdk:"disk_id"`
+	Snapshots []DiskSnapshotDataSourceModel `tfsdk:"snapshots"`
+}
+
+type DiskSnapshotDataSourceModel struct {
+	SnapshotKey types.String `tfsdk:"snapshot_key"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+}
+
+func (d *DiskSnapshotsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_disk_snapshots"
+}
+
+func (d *DiskSnapshotsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get a list of the snapshots a disk can be restored from.",
+		Attributes: map[string]schema.Attribute{
+			"disk_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the disk",
+				Required:            true,
+			},
+			"snapshots": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"snapshot_key": schema.StringAttribute{
+							MarkdownDescription: "The key used to restore the snapshot",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "The date and time the snapshot was taken",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DiskSnapshotsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*render.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = api.NewClient(client)
+}
+
+func (d *DiskSnapshotsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state DiskSnapshotsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	snapshots, err := d.client.GetDiskSnapshots(state.DiskID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Render Disk Snapshots",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Snapshots = []DiskSnapshotDataSourceModel{}
+	for _, snapshot := range snapshots {
+		state.Snapshots = append(state.Snapshots, DiskSnapshotDataSourceModel{
+			SnapshotKey: types.StringValue(snapshot.SnapshotKey),
+			CreatedAt:   types.StringValue(snapshot.CreatedAt),
+		})
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"terraform-provider-render/internal/fakerender"
+)
+
+func TestDiskResource(t *testing.T) {
+	server, providerConfig := newTestServer(t)
+
+	var id string
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckDiskDestroy(server),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testWebServiceConfig("my-app", "starter", 1) + testDiskConfig("data", 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("render_disk.test", "id", func(value string) error {
+						id = value
+						return nil
+					}),
+					resource.TestCheckResourceAttrPair("render_disk.test", "service_id", "render_web_service.test", "id"),
+					resource.TestCheckResourceAttr("render_disk.test", "name", "data"),
+					resource.TestCheckResourceAttr("render_disk.test", "mount_path", "/var/data"),
+					resource.TestCheckResourceAttr("render_disk.test", "size_gb", "5"),
+					resource.TestCheckResourceAttrSet("render_disk.test", "created_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "render_disk.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing: the disk grows in place
+			{
+				Config: providerConfig + testWebServiceConfig("my-app", "starter", 1) + testDiskConfig("storage", 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("render_disk.test", "id", &id),
+					resource.TestCheckResourceAttr("render_disk.test", "name", "storage"),
+					resource.TestCheckResourceAttr("render_disk.test", "size_gb", "10"),
+				),
+			},
+			// Disks can't shrink
+			{
+				Config:      providerConfig + testWebServiceConfig("my-app", "starter", 1) + testDiskConfig("storage", 5),
+				ExpectError: regexp.MustCompile("Disks can only grow"),
+			},
+		},
+	})
+}
+
+func TestDiskSnapshotsDataSource(t *testing.T) {
+	server, providerConfig := newTestServer(t)
+
+	var snapshotKey string
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckDiskDestroy(server),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testWebServiceConfig("my-app", "starter", 1) + testDiskConfig("data", 5),
+			},
+			{
+				PreConfig: func() {
+					snapshotKey = server.AddDiskSnapshot(server.DiskIDs()[0])
+				},
+				Config: providerConfig + testWebServiceConfig("my-app", "starter", 1) + testDiskConfig("data", 5) + `
+data "render_disk_snapshots" "test" {
+  disk_id = render_disk.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.render_disk_snapshots.test", "snapshots.#", "1"),
+					resource.TestCheckResourceAttrPtr("data.render_disk_snapshots.test", "snapshots.0.snapshot_key", &snapshotKey),
+					resource.TestCheckResourceAttrSet("data.render_disk_snapshots.test", "snapshots.0.created_at"),
+				),
+			},
+		},
+	})
+}
+
+func testDiskConfig(name string, sizeGB int) string {
+	return fmt.Sprintf(`
+resource "render_disk" "test" {
+  service_id = render_web_service.test.id
+  name       = %q
+  mount_path = "/var/data"
+  size_gb    = %d
+}
+`, name, sizeGB)
+}
+
+func testCheckDiskDestroy(server *fakerender.Server) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if ids := server.DiskIDs(); len(ids) > 0 {
+			return fmt.Errorf("disks still exist: %v", ids)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-render/internal/fakerender"
+)
+
+func TestValidateDuration(t *testing.T) {
+	tests := map[string]bool{
+		"30s":   true,
+		"1m":    true,
+		"1h30m": true,
+		"500ms": true,
+		"0s":    false,
+		"0":     false,
+		"-1s":   false,
+		"":      false,
+		"30":    false,
+		"soon":  false,
+	}
+
+	for value, valid := range tests {
+		err := validateDuration(value)
+		if valid && err != nil {
+			t.Errorf("expected %q to be valid, got: %s", value, err)
+		}
+		if !valid && err == nil {
+			t.Errorf("expected %q to be invalid", value)
+		}
+	}
+}
+
+func TestProviderSettings(t *testing.T) {
+	server, _ := newTestServer(t)
+
+	config := func(name, value string) string {
+		return fmt.Sprintf(`
+provider "render" {
+  api_key = %q
+  api_url = %q
+  %s = %s
+}
+
+data "render_owner" "test" {
+  id = %q
+}
+`, fakerender.APIKey, server.URL, name, value, testOwnerID)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config("request_timeout", `"0s"`),
+				ExpectError: regexp.MustCompile("Invalid duration"),
+			},
+			{
+				Config:      config("max_retry_wait", `"-1s"`),
+				ExpectError: regexp.MustCompile("Invalid duration"),
+			},
+			{
+				Config:      config("max_retries", "-1"),
+				ExpectError: regexp.MustCompile("max_retries value must be at least 0"),
+			},
+			{
+				Config:      config("max_requests_per_minute", "0"),
+				ExpectError: regexp.MustCompile("max_requests_per_minute value must be at least 1"),
+			},
+			{
+				Config: config("request_timeout", `"30s"`),
+				Check:  resource.TestCheckResourceAttr("data.render_owner.test", "id", testOwnerID),
+			},
+		},
+	})
+}
@@ -0,0 +1,281 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sonlir/render-client-go"
+
+	"terraform-provider-render/internal/api"
+)
+
+var (
+	_ resource.Resource                 = &EnvGroup{}
+	_ resource.ResourceWithConfigure    = &EnvGroup{}
+	_ resource.ResourceWithImportState  = &EnvGroup{}
+	_ resource.ResourceWithUpgradeState = &EnvGroup{}
+	_ resource.ResourceWithModifyPlan   = &EnvGroup{}
+)
+
+func NewEnvGroup() resource.Resource {
+	return &EnvGroup{}
+}
+
+type EnvGroup struct {
+	client *api.Client
+}
+
+type EnvGroupModel struct {
+	ID          types.String                   `tfsdk:"id"`
+	Name        types.String                   `tfsdk:"name"`
+	OwnerID     types.String                   `tfsdk:"owner_id"`
+	EnvVars     map[string]EnvironmentVariable `tfsdk:"environment_variables"`
+	SecretFiles []SecretFiles                  `tfsdk:"secret_files"`
+	CreatedAt   types.String                   `tfsdk:"created_at"`
+	UpdatedAt   types.String                   `tfsdk:"updated_at"`
+}
+
+func (r *EnvGroup) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_env_group"
+}
+
+func (r *EnvGroup) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Creates a new Render environment group owned by you or a team you belong to. Use `render_env_group_link` to share the group with services.",
+		Version:             1,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the environment group",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the environment group",
+				Required:            true,
+			},
+			"owner_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the owner of the environment group",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"environment_variables": schema.MapNestedAttribute{
+				MarkdownDescription: "The environment variables in the group, keyed by name. Default: `{}`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             mapdefault.StaticValue(types.MapValueMust(environmentVariableType, map[string]attr.Value{})),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: environmentVariableAttributes(),
+				},
+			},
+			"secret_files": schema.ListNestedAttribute{
+				MarkdownDescription: "The secret files in the group. Default: `[]`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(types.ListValueMust(secretFileType, []attr.Value{})),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the secret file",
+							Required:            true,
+						},
+						"content": schema.StringAttribute{
+							MarkdownDescription: "The content of the secret file",
+							Required:            true,
+							Sensitive:           true,
+						},
+					},
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The date and time the environment group was created",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "The date and time the environment group was last updated",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *EnvGroup) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planUnchangedEnvVars(req, resp)
+}
+
+func (r *EnvGroup) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*render.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = api.NewClient(client)
+}
+
+func (r *EnvGroup) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan EnvGroupModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	envGroup, err := r.client.CreateEnvGroup(makeEnvGroupData(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Render environment group",
+			"Could not create environment group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	makeEnvGroupModel(&plan, envGroup)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *EnvGroup) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state EnvGroupModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	envGroup, err := r.client.GetEnvGroup(state.ID.ValueString())
+	if api.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not get Render environment group: "+state.ID.ValueString(),
+			err.Error(),
+		)
+		return
+	}
+
+	makeEnvGroupModel(&state, envGroup)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *EnvGroup) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state EnvGroupModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+
+	envGroup, err := r.client.UpdateEnvGroup(plan.ID.ValueString(), makeEnvGroupData(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Render environment group",
+			"Could not update environment group ID: "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	makeEnvGroupModel(&plan, envGroup)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *EnvGroup) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state EnvGroupModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteEnvGroup(state.ID.ValueString())
+	if err != nil && !api.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting Render environment group",
+			"Could not delete environment group ID: "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *EnvGroup) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *EnvGroup) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return envVarsStateUpgraders()
+}
+
+func makeEnvGroupModel(state *EnvGroupModel, envGroup *api.EnvGroup) {
+	state.ID = types.StringValue(envGroup.ID)
+	state.Name = types.StringValue(envGroup.Name)
+	state.OwnerID = types.StringValue(envGroup.OwnerID)
+	state.EnvVars = makeEnvVarsModel(state.EnvVars, envGroup.EnvVars)
+	state.SecretFiles = []SecretFiles{}
+	for _, secretFile := range envGroup.SecretFiles {
+		state.SecretFiles = append(state.SecretFiles, SecretFiles{
+			Name:     types.StringValue(secretFile.Name),
+			Contents: types.StringValue(secretFile.Content),
+		})
+	}
+	state.CreatedAt = types.StringValue(envGroup.CreatedAt)
+	state.UpdatedAt = types.StringValue(envGroup.UpdatedAt)
+}
+
+func makeEnvGroupData(plan *EnvGroupModel) api.EnvGroupData {
+	data := api.EnvGroupData{
+		EnvVars:     makeEnvVarsData(plan.EnvVars),
+		Name:        plan.Name.ValueString(),
+		OwnerID:     plan.OwnerID.ValueString(),
+		SecretFiles: []api.SecretFile{},
+	}
+
+	for _, secretFile := range plan.SecretFiles {
+		data.SecretFiles = append(data.SecretFiles, api.SecretFile{
+			Name:    secretFile.Name.ValueString(),
+			Content: secretFile.Contents.ValueString(),
+		})
+	}
+
+	return data
+}
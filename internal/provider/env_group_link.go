@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sonlir/render-client-go"
+
+	"terraform-provider-render/internal/api"
+)
+
+var (
+	_ resource.Resource                = &EnvGroupLink{}
+	_ resource.ResourceWithConfigure   = &EnvGroupLink{}
+	_ resource.ResourceWithImportState = &EnvGroupLink{}
+)
+
+func NewEnvGroupLink() resource.Resource {
+	return &EnvGroupLink{}
+}
+
+type EnvGroupLink struct {
+	client *api.Client
+}
+
+type EnvGroupLinkModel struct {
+	ID         types.String `tfsdk:"id"`
+	EnvGroupID types.String `tfsdk:"env_group_id"`
+	ServiceID  types.String `tfsdk:"service_id"`
+}
+
+func (r *EnvGroupLink) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_env_group_link"
+}
+
+func (r *EnvGroupLink) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Links a Render environment group to a service. The service receives every variable and secret file in the group.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the link, in the form `<env_group_id>/<service_id>`",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"env_group_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the environment group",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the service",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+		},
+	}
+}
+
+func (r *EnvGroupLink) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*render.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = api.NewClient(client)
+}
+
+func (r *EnvGroupLink) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan EnvGroupLinkModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.LinkEnvGroupService(plan.EnvGroupID.ValueString(), plan.ServiceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Render environment group link",
+			"Could not link environment group ID: "+plan.EnvGroupID.ValueString()+" to service ID: "+plan.ServiceID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.EnvGroupID.ValueString() + "/" + plan.ServiceID.ValueString())
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *EnvGroupLink) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state EnvGroupLinkModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	envGroup, err := r.client.GetEnvGroup(state.EnvGroupID.ValueString())
+	if api.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not get Render environment group: "+state.EnvGroupID.ValueString(),
+			err.Error(),
+		)
+		return
+	}
+
+	linked := false
+	for _, link := range envGroup.ServiceLinks {
+		if link.ID == state.ServiceID.ValueString() {
+			linked = true
+			break
+		}
+	}
+	if !linked {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update is never called because every attribute requires replacement.
+func (r *EnvGroupLink) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+}
+
+func (r *EnvGroupLink) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state EnvGroupLinkModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UnlinkEnvGroupService(state.EnvGroupID.ValueString(), state.ServiceID.ValueString())
+	if err != nil && !api.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting Render environment group link",
+			"Could not unlink environment group ID: "+state.EnvGroupID.ValueString()+" from service ID: "+state.ServiceID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *EnvGroupLink) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	envGroupID, serviceID, ok := strings.Cut(req.ID, "/")
+	if !ok || envGroupID == "" || serviceID == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <env_group_id>/<service_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("env_group_id"), envGroupID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), serviceID)...)
+}
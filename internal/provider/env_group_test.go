@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"terraform-provider-render/internal/fakerender"
+)
+
+func TestEnvGroupResource(t *testing.T) {
+	server, providerConfig := newTestServer(t)
+
+	var id string
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckEnvGroupDestroy(server),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testEnvGroupConfig("shared", "info"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("render_env_group.test", "id", func(value string) error {
+						id = value
+						return nil
+					}),
+					resource.TestCheckResourceAttr("render_env_group.test", "name", "shared"),
+					resource.TestCheckResourceAttr("render_env_group.test", "owner_id", testOwnerID),
+					resource.TestCheckResourceAttr("render_env_group.test", "environment_variables.%", "2"),
+					resource.TestCheckResourceAttr("render_env_group.test", "environment_variables.LOG_LEVEL.value", "info"),
+					resource.TestCheckResourceAttrSet("render_env_group.test", "environment_variables.SESSION_SECRET.value"),
+					resource.TestCheckResourceAttr("render_env_group.test", "secret_files.#", "1"),
+					resource.TestCheckResourceAttr("render_env_group.test", "secret_files.0.name", "config.json"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "render_env_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Import can't tell how a value was set.
+				ImportStateVerifyIgnore: []string{"environment_variables.SESSION_SECRET.generate_value"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testEnvGroupConfig("shared-renamed", "debug"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("render_env_group.test", "id", &id),
+					resource.TestCheckResourceAttr("render_env_group.test", "name", "shared-renamed"),
+					resource.TestCheckResourceAttr("render_env_group.test", "environment_variables.LOG_LEVEL.value", "debug"),
+				),
+			},
+		},
+	})
+}
+
+func TestEnvGroupLinkResource(t *testing.T) {
+	server, providerConfig := newTestServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckEnvGroupDestroy(server),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testWebServiceConfig("my-app", "starter", 1) + testEnvGroupConfig("shared", "info") + testEnvGroupLinkConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("render_env_group_link.test", "env_group_id", "render_env_group.test", "id"),
+					resource.TestCheckResourceAttrPair("render_env_group_link.test", "service_id", "render_web_service.test", "id"),
+					testCheckEnvGroupLinked(server, "render_env_group.test", "render_web_service.test", true),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "render_env_group_link.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Removing the link unlinks the service
+			{
+				Config: providerConfig + testWebServiceConfig("my-app", "starter", 1) + testEnvGroupConfig("shared", "info"),
+				Check:  testCheckEnvGroupLinked(server, "render_env_group.test", "render_web_service.test", false),
+			},
+		},
+	})
+}
+
+func testEnvGroupConfig(name, logLevel string) string {
+	return fmt.Sprintf(`
+resource "render_env_group" "test" {
+  name     = %q
+  owner_id = %q
+
+  environment_variables = {
+    LOG_LEVEL      = { value = %q }
+    SESSION_SECRET = { generate_value = true }
+  }
+
+  secret_files = [
+    { name = "config.json", content = "{}" },
+  ]
+}
+`, name, testOwnerID, logLevel)
+}
+
+const testEnvGroupLinkConfig = `
+resource "render_env_group_link" "test" {
+  env_group_id = render_env_group.test.id
+  service_id   = render_web_service.test.id
+}
+`
+
+// testCheckEnvGroupLinked checks whether the service is linked to the
+// environment group.
+func testCheckEnvGroupLinked(server *fakerender.Server, envGroupName, serviceName string, linked bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		envGroup, ok := s.RootModule().Resources[envGroupName]
+		if !ok {
+			return fmt.Errorf("%s not found in state", envGroupName)
+		}
+		service, ok := s.RootModule().Resources[serviceName]
+		if !ok {
+			return fmt.Errorf("%s not found in state", serviceName)
+		}
+		found := false
+		for _, id := range server.EnvGroupServiceIDs(envGroup.Primary.ID) {
+			found = found || id == service.Primary.ID
+		}
+		if found != linked {
+			return fmt.Errorf("expected the link between %s and %s to exist: %t", envGroupName, serviceName, linked)
+		}
+		return nil
+	}
+}
+
+func testCheckEnvGroupDestroy(server *fakerender.Server) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if ids := server.EnvGroupIDs(); len(ids) > 0 {
+			return fmt.Errorf("environment groups still exist: %v", ids)
+		}
+		return nil
+	}
+}
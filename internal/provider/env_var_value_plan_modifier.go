@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ planmodifier.String = envVarValueModifier{}
+
+// envVarValueModifier plans the value of environment variables that aren't
+// fully managed by Terraform. Generated and ignored values keep the value in
+// state, which Read updates with the value in Render, so that changes made
+// outside of Terraform are not reverted. New ignored variables without a
+// value are created empty.
+type envVarValueModifier struct{}
+
+func (m envVarValueModifier) Description(_ context.Context) string {
+	return "generated and ignored values keep the value of the existing environment variable"
+}
+
+func (m envVarValueModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m envVarValueModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	var generateValue, ignoreValue types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, req.Path.ParentPath().AtName("generate_value"), &generateValue)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, req.Path.ParentPath().AtName("ignore_value"), &ignoreValue)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	generated := generateValue.ValueBool() && req.ConfigValue.IsNull()
+	if !generated && !ignoreValue.ValueBool() {
+		return
+	}
+
+	if !req.StateValue.IsNull() {
+		resp.PlanValue = req.StateValue
+		return
+	}
+	if !generated && req.ConfigValue.IsNull() {
+		resp.PlanValue = types.StringValue("")
+	}
+}
+
+// planUnchangedEnvVars plans no change when envVarValueModifier kept the state
+// value of every variable that differed from the configuration. The
+// framework marks the computed attributes without a configured value, like
+// updated_at, unknown before the modifier runs, so they get their state value
+// back. Unknown values that come from the configuration are kept.
+func planUnchangedEnvVars(req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	plan, err := tftypes.Transform(resp.Plan.Raw, func(p *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if v.IsKnown() {
+			return v, nil
+		}
+		if config, _, err := tftypes.WalkAttributePath(req.Config.Raw, p); err == nil {
+			if configValue, ok := config.(tftypes.Value); ok && !configValue.IsNull() {
+				return v, nil
+			}
+		}
+		state, _, err := tftypes.WalkAttributePath(req.State.Raw, p)
+		if err != nil {
+			return v, nil
+		}
+		if stateValue, ok := state.(tftypes.Value); ok {
+			return stateValue, nil
+		}
+		return v, nil
+	})
+	if err == nil && plan.Equal(req.State.Raw) {
+		resp.Plan.Raw = req.State.Raw
+	}
+}
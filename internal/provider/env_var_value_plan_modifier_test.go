@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestEnvVarValueModifier(t *testing.T) {
+	tests := map[string]struct {
+		config        types.String
+		state         types.String
+		generateValue bool
+		ignoreValue   bool
+		expected      types.String
+	}{
+		"managed":              {config: types.StringValue("2"), state: types.StringValue("1"), expected: types.StringValue("2")},
+		"new generated":        {config: types.StringNull(), state: types.StringNull(), generateValue: true, expected: types.StringUnknown()},
+		"generated":            {config: types.StringNull(), state: types.StringValue("s3cr3t"), generateValue: true, expected: types.StringValue("s3cr3t")},
+		"new ignored":          {config: types.StringValue("1"), state: types.StringNull(), ignoreValue: true, expected: types.StringValue("1")},
+		"new ignored no value": {config: types.StringNull(), state: types.StringNull(), ignoreValue: true, expected: types.StringValue("")},
+		"ignored":              {config: types.StringValue("1"), state: types.StringValue("rotated"), ignoreValue: true, expected: types.StringValue("rotated")},
+		"ignored no value":     {config: types.StringNull(), state: types.StringValue("rotated"), ignoreValue: true, expected: types.StringValue("rotated")},
+	}
+
+	ctx := context.Background()
+	for name, test := range tests {
+		var planValue any = tftypes.UnknownValue
+		if !test.config.IsNull() {
+			planValue = test.config.ValueString()
+		}
+		s, raw := testEnvVarsRaw(planValue, test.generateValue, test.ignoreValue)
+		planned := types.StringUnknown()
+		if !test.config.IsNull() {
+			planned = test.config
+		}
+
+		req := planmodifier.StringRequest{
+			Path:        testEnvVarPath,
+			ConfigValue: test.config,
+			StateValue:  test.state,
+			PlanValue:   planned,
+			Plan:        tfsdk.Plan{Schema: s, Raw: raw},
+		}
+		resp := &planmodifier.StringResponse{PlanValue: planned}
+		envVarValueModifier{}.PlanModifyString(ctx, req, resp)
+
+		if resp.Diagnostics.HasError() {
+			t.Errorf("%s: unexpected error: %v", name, resp.Diagnostics)
+			continue
+		}
+		if !resp.PlanValue.Equal(test.expected) {
+			t.Errorf("%s: got %s, want %s", name, resp.PlanValue, test.expected)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ validator.String = envVarValueValidator{}
+
+// envVarValueValidator checks the value of an environment variable against
+// its generate_value and ignore_value siblings: a value is required unless
+// Render generates it or it is ignored, and can't be combined with
+// generate_value.
+type envVarValueValidator struct{}
+
+func (v envVarValueValidator) Description(_ context.Context) string {
+	return "value must be set unless generate_value or ignore_value is true, and must not be set if generate_value is true"
+}
+
+func (v envVarValueValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v envVarValueValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var generateValue, ignoreValue types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, req.Path.ParentPath().AtName("generate_value"), &generateValue)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, req.Path.ParentPath().AtName("ignore_value"), &ignoreValue)...)
+	if resp.Diagnostics.HasError() || generateValue.IsUnknown() || ignoreValue.IsUnknown() {
+		return
+	}
+
+	if generateValue.ValueBool() && !req.ConfigValue.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Conflicting Environment Variable Value",
+			"The value of an environment variable with generate_value set is generated by Render and cannot be configured.",
+		)
+	}
+	if !generateValue.ValueBool() && !ignoreValue.ValueBool() && req.ConfigValue.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Missing Environment Variable Value",
+			"The value of an environment variable is required unless generate_value or ignore_value is set.",
+		)
+	}
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testEnvVarPath is the path of the value of the environment variable in
+// testEnvVarsRaw.
+var testEnvVarPath = path.Root("environment_variables").AtMapKey("A").AtName("value")
+
+// testEnvVarsRaw returns a schema with environment variables and a value in
+// which variable A has the given attributes. Nil attributes are null.
+func testEnvVarsRaw(value, generateValue, ignoreValue any) (schema.Schema, tftypes.Value) {
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"environment_variables": schema.MapNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: environmentVariableAttributes(),
+				},
+			},
+		},
+	}
+	objectType := s.Type().TerraformType(context.Background()).(tftypes.Object)
+	mapType := objectType.AttributeTypes["environment_variables"].(tftypes.Map)
+	envVarType := mapType.ElementType.(tftypes.Object)
+
+	envVar := tftypes.NewValue(envVarType, map[string]tftypes.Value{
+		"value":          tftypes.NewValue(tftypes.String, value),
+		"generate_value": tftypes.NewValue(tftypes.Bool, generateValue),
+		"ignore_value":   tftypes.NewValue(tftypes.Bool, ignoreValue),
+	})
+	return s, tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"environment_variables": tftypes.NewValue(mapType, map[string]tftypes.Value{"A": envVar}),
+	})
+}
+
+func TestEnvVarValueValidator(t *testing.T) {
+	tests := map[string]struct {
+		value         any
+		generateValue any
+		ignoreValue   any
+		valid         bool
+	}{
+		"value":                    {value: "1", valid: true},
+		"value with false flags":   {value: "1", generateValue: false, ignoreValue: false, valid: true},
+		"generated":                {generateValue: true, valid: true},
+		"ignored":                  {ignoreValue: true, valid: true},
+		"ignored with value":       {value: "1", ignoreValue: true, valid: true},
+		"unknown value":            {value: tftypes.UnknownValue, valid: true},
+		"unknown generate_value":   {generateValue: tftypes.UnknownValue, valid: true},
+		"missing value":            {valid: false},
+		"missing value with false": {generateValue: false, ignoreValue: false, valid: false},
+		"generated with value":     {value: "1", generateValue: true, valid: false},
+	}
+
+	ctx := context.Background()
+	for name, test := range tests {
+		s, raw := testEnvVarsRaw(test.value, test.generateValue, test.ignoreValue)
+		config := tfsdk.Config{Schema: s, Raw: raw}
+		var value types.String
+		if diags := config.GetAttribute(ctx, testEnvVarPath, &value); diags.HasError() {
+			t.Fatalf("%s: %v", name, diags)
+		}
+
+		req := validator.StringRequest{Path: testEnvVarPath, ConfigValue: value, Config: config}
+		resp := &validator.StringResponse{}
+		envVarValueValidator{}.ValidateString(ctx, req, resp)
+
+		if test.valid && resp.Diagnostics.HasError() {
+			t.Errorf("%s: unexpected error: %v", name, resp.Diagnostics)
+		}
+		if !test.valid && !resp.Diagnostics.HasError() {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
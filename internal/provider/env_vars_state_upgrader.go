@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// envVarsStateUpgraders upgrades the state of resources with environment
+// variables from schema version 0, in which they were a list of key and value
+// objects, to a map keyed by name.
+func envVarsStateUpgraders() map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {StateUpgrader: upgradeEnvVarsState},
+	}
+}
+
+// upgradeEnvVarsState rewrites the raw state instead of decoding it with the
+// prior schema, so that the upgrade can be shared by all resources with
+// environment variables. Nothing else changed in version 1.
+func upgradeEnvVarsState(_ context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var state map[string]json.RawMessage
+	if err := json.Unmarshal(req.RawState.JSON, &state); err != nil {
+		resp.Diagnostics.AddError("Unable to Upgrade Resource State", "Unable to read the prior state: "+err.Error())
+		return
+	}
+
+	var envVars []struct {
+		Key   *string `json:"key"`
+		Value *string `json:"value"`
+	}
+	if raw, ok := state["environment_variables"]; ok {
+		if err := json.Unmarshal(raw, &envVars); err != nil {
+			resp.Diagnostics.AddError("Unable to Upgrade Resource State", "Unable to read the prior environment variables: "+err.Error())
+			return
+		}
+	}
+	// Null environment variables stay null.
+	if envVars != nil {
+		upgraded := map[string]map[string]*string{}
+		for _, envVar := range envVars {
+			if envVar.Key == nil {
+				continue
+			}
+			upgraded[*envVar.Key] = map[string]*string{"value": envVar.Value}
+		}
+		raw, err := json.Marshal(upgraded)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Upgrade Resource State", err.Error())
+			return
+		}
+		state["environment_variables"] = raw
+	}
+
+	upgradedState, err := json.Marshal(state)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Upgrade Resource State", err.Error())
+		return
+	}
+	resp.DynamicValue = &tfprotov6.DynamicValue{JSON: upgradedState}
+}
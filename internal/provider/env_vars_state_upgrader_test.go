@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+func TestUpgradeEnvVarsState(t *testing.T) {
+	tests := map[string]struct {
+		prior    string
+		expected map[string]any
+	}{
+		"list": {
+			prior: `{"id":"srv-1","environment_variables":[{"key":"B","value":"2"},{"key":"A","value":"1"}]}`,
+			expected: map[string]any{
+				"id": "srv-1",
+				"environment_variables": map[string]any{
+					"A": map[string]any{"value": "1"},
+					"B": map[string]any{"value": "2"},
+				},
+			},
+		},
+		"empty": {
+			prior:    `{"id":"srv-1","environment_variables":[]}`,
+			expected: map[string]any{"id": "srv-1", "environment_variables": map[string]any{}},
+		},
+		"null": {
+			prior:    `{"id":"srv-1","environment_variables":null}`,
+			expected: map[string]any{"id": "srv-1", "environment_variables": nil},
+		},
+		"null value": {
+			prior: `{"id":"srv-1","environment_variables":[{"key":"A","value":null}]}`,
+			expected: map[string]any{
+				"id":                    "srv-1",
+				"environment_variables": map[string]any{"A": map[string]any{"value": nil}},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		req := resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(test.prior)}}
+		resp := &resource.UpgradeStateResponse{}
+		upgradeEnvVarsState(context.Background(), req, resp)
+		if resp.Diagnostics.HasError() {
+			t.Errorf("%s: unexpected error: %v", name, resp.Diagnostics)
+			continue
+		}
+
+		var upgraded map[string]any
+		if err := json.Unmarshal(resp.DynamicValue.JSON, &upgraded); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(upgraded, test.expected) {
+			t.Errorf("%s: got %v, want %v", name, upgraded, test.expected)
+		}
+	}
+}
+
+// TestUpgradeEnvVarsStateSchemas makes sure that the upgraded state matches
+// the current schema of every resource using the upgrade.
+func TestUpgradeEnvVarsStateSchemas(t *testing.T) {
+	prior := `{"id":"srv-1","name":"my-app","environment_variables":[{"key":"A","value":"1"}]}`
+	resources := map[string]resource.ResourceWithUpgradeState{
+		"render_web_service":       &WebService{},
+		"render_private_service":   &PrivateService{},
+		"render_background_worker": &BackgroundWorker{},
+		"render_cron_job":          &CronJob{},
+		"render_static_site":       &StaticSite{},
+		"render_env_group":         &EnvGroup{},
+	}
+
+	ctx := context.Background()
+	for name, r := range resources {
+		schemaResp := &resource.SchemaResponse{}
+		r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+		if schemaResp.Schema.Version != 1 {
+			t.Errorf("%s: expected schema version 1, got %d", name, schemaResp.Schema.Version)
+		}
+
+		upgrader, ok := r.UpgradeState(ctx)[0]
+		if !ok {
+			t.Errorf("%s: expected an upgrade from version 0", name)
+			continue
+		}
+		req := resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(prior)}}
+		resp := &resource.UpgradeStateResponse{}
+		upgrader.StateUpgrader(ctx, req, resp)
+		if resp.Diagnostics.HasError() {
+			t.Errorf("%s: unexpected error: %v", name, resp.Diagnostics)
+			continue
+		}
+		if _, err := resp.DynamicValue.Unmarshal(schemaResp.Schema.Type().TerraformType(ctx)); err != nil {
+			t.Errorf("%s: upgraded state doesn't match the schema: %v", name, err)
+		}
+	}
+}
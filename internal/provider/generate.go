@@ -0,0 +1,273 @@
+package provider
+
+import (
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zclconf/go-cty/cty"
+
+	"terraform-provider-render/internal/api"
+)
+
+// GenerateOptions configures GenerateConfig.
+type GenerateOptions struct {
+	// OwnerID limits the services to a single owner, empty means every
+	// service the API key can access.
+	OwnerID string
+	// ImportsOnly leaves out the resource bodies, so that Terraform can write
+	// them with `terraform plan -generate-config-out`.
+	ImportsOnly bool
+	// IncludeSecrets writes the values of environment variables and the
+	// contents of secret files. Otherwise the environment variables ignore
+	// their values, and the secret files are read from secretFilesDir.
+	IncludeSecrets bool
+}
+
+// secretFilesDir is the directory the generated configuration reads secret
+// files from, in a subdirectory for each resource.
+const secretFilesDir = "secrets"
+
+// GenerateConfig writes an import block for every web service, each followed
+// by a render_web_service resource that matches the service, so that the
+// first plan after importing them only sets ignore_value on the environment
+// variables, or is empty with IncludeSecrets.
+func GenerateConfig(client *api.Client, opts GenerateOptions, w io.Writer) error {
+	services, err := client.ListServices(&api.ListServicesArgs{OwnerID: opts.OwnerID, Type: "web_service"})
+	if err != nil {
+		return fmt.Errorf("could not list web services: %w", err)
+	}
+	sort.Slice(services, func(i, j int) bool {
+		return services[i].Name < services[j].Name
+	})
+
+	file := hclwrite.NewEmptyFile()
+	body := file.Body()
+	names := map[string]bool{}
+	for i, listed := range services {
+		name := resourceName(listed.Name, names)
+
+		if i > 0 {
+			body.AppendNewline()
+		}
+		importBlock := body.AppendNewBlock("import", nil)
+		importBlock.Body().SetAttributeTraversal("to", hcl.Traversal{
+			hcl.TraverseRoot{Name: "render_web_service"},
+			hcl.TraverseAttr{Name: name},
+		})
+		importBlock.Body().SetAttributeValue("id", cty.StringVal(listed.ID))
+
+		if opts.ImportsOnly {
+			continue
+		}
+
+		service, err := client.GetService(listed.ID)
+		if err != nil {
+			return fmt.Errorf("could not get web service %s: %w", listed.ID, err)
+		}
+		service.ServiceDetails.Disk, err = client.GetServiceDisk(service)
+		if err != nil {
+			return fmt.Errorf("could not get disk for web service %s: %w", listed.ID, err)
+		}
+		service.SecretFiles, err = client.GetServiceSecretFiles(listed.ID)
+		if err != nil {
+			return fmt.Errorf("could not get secret files for web service %s: %w", listed.ID, err)
+		}
+
+		// An empty model reads the service the same way an import does.
+		var model WebServiceModel
+		webServiceConversion.makeModel(&model, service)
+
+		body.AppendNewline()
+		resourceBlock := body.AppendNewBlock("resource", []string{"render_web_service", name})
+		writeWebServiceConfig(resourceBlock.Body(), name, &model, opts.IncludeSecrets)
+	}
+
+	_, err = w.Write(hclwrite.Format(file.Bytes()))
+	return err
+}
+
+func writeWebServiceConfig(body *hclwrite.Body, name string, model *WebServiceModel, includeSecrets bool) {
+	setAttribute(body, "name", stringValue(model.Name))
+	setAttribute(body, "owner_id", stringValue(model.OwnerID))
+	setAttribute(body, "repo", stringValue(model.Repo))
+	setAttribute(body, "branch", stringValue(model.Branch))
+	setAttribute(body, "auto_deploy", stringValue(model.AutoDeploy))
+	setAttribute(body, "root_dir", stringValue(model.RootDir))
+
+	if model.Image != nil {
+		setAttribute(body, "image", objectValue(map[string]cty.Value{
+			"owner_id":               stringValue(model.Image.OwnerID),
+			"registry_credential_id": stringValue(model.Image.RegistryCredentialId),
+			"image_path":             stringValue(model.Image.ImagePath),
+		}))
+	}
+	if model.BuildFilter != nil {
+		setAttribute(body, "build_filter", objectValue(map[string]cty.Value{
+			"paths":         stringsValue(model.BuildFilter.Paths),
+			"ignored_paths": stringsValue(model.BuildFilter.IgnoredPaths),
+		}))
+	}
+
+	details := model.ServiceDetails
+	serviceDetails := map[string]cty.Value{
+		"env":                           stringValue(details.Env),
+		"plan":                          stringValue(details.Plan),
+		"region":                        stringValue(details.Region),
+		"num_instances":                 int64Value(details.NumInstances),
+		"health_check_path":             stringValue(details.HealthCheckPath),
+		"pull_request_previews_enabled": stringValue(details.PullRequestPreviewsEnabled),
+	}
+	if details.NativeEnvironmentDetails != nil {
+		serviceDetails["native_environment_details"] = objectValue(map[string]cty.Value{
+			"build_command":      stringValue(details.NativeEnvironmentDetails.BuildCommand),
+			"start_command":      stringValue(details.NativeEnvironmentDetails.StartCommand),
+			"pre_deploy_command": stringValue(details.NativeEnvironmentDetails.PreDeployCommand),
+		})
+	}
+	if details.DockerDetails != nil {
+		serviceDetails["docker_details"] = objectValue(map[string]cty.Value{
+			"docker_command":         stringValue(details.DockerDetails.DockerCommand),
+			"docker_context":         stringValue(details.DockerDetails.DockerContext),
+			"dockerfile_path":        stringValue(details.DockerDetails.DockerfilePath),
+			"pre_deploy_command":     stringValue(details.DockerDetails.PreDeployCommand),
+			"registry_credential_id": stringValue(details.DockerDetails.RegistryCredentialId),
+		})
+	}
+	if details.Disk != nil {
+		serviceDetails["disk"] = objectValue(map[string]cty.Value{
+			"name":       stringValue(details.Disk.Name),
+			"mount_path": stringValue(details.Disk.MountPath),
+			"size_gb":    int64Value(details.Disk.SizeGB),
+		})
+	}
+	if details.Autoscaling != nil {
+		serviceDetails["autoscaling"] = objectValue(map[string]cty.Value{
+			"enabled": boolValue(details.Autoscaling.Enabled),
+			"min":     int64Value(details.Autoscaling.Min),
+			"max":     int64Value(details.Autoscaling.Max),
+			"criteria": objectValue(map[string]cty.Value{
+				"cpu": objectValue(map[string]cty.Value{
+					"enabled":    boolValue(details.Autoscaling.Criteria.CPU.Enabled),
+					"percentage": int64Value(details.Autoscaling.Criteria.CPU.Percentage),
+				}),
+				"memory": objectValue(map[string]cty.Value{
+					"enabled":    boolValue(details.Autoscaling.Criteria.Memory.Enabled),
+					"percentage": int64Value(details.Autoscaling.Criteria.Memory.Percentage),
+				}),
+			}),
+		})
+	}
+	setAttribute(body, "service_details", objectValue(serviceDetails))
+
+	if len(model.EnvVars) > 0 {
+		envVars := map[string]cty.Value{}
+		for key, envVar := range model.EnvVars {
+			if !includeSecrets {
+				envVars[key] = cty.ObjectVal(map[string]cty.Value{"ignore_value": cty.True})
+				continue
+			}
+			// Empty values are kept, unlike the other attributes.
+			envVars[key] = cty.ObjectVal(map[string]cty.Value{
+				"value": cty.StringVal(envVar.Value.ValueString()),
+			})
+		}
+		setAttribute(body, "environment_variables", cty.ObjectVal(envVars))
+	}
+	if files := secretFilesElements(model.SecretFiles); len(files) > 0 {
+		secretFiles := []hclwrite.Tokens{}
+		for _, secretFile := range files {
+			content := hclwrite.TokensForValue(cty.StringVal(secretFile.Contents.ValueString()))
+			if !includeSecrets {
+				content = hclwrite.TokensForFunctionCall("file", hclwrite.TokensForValue(
+					cty.StringVal(path.Join(secretFilesDir, name, secretFile.Name.ValueString())),
+				))
+			}
+			secretFiles = append(secretFiles, hclwrite.TokensForObject([]hclwrite.ObjectAttrTokens{
+				{Name: hclwrite.TokensForIdentifier("content"), Value: content},
+				{Name: hclwrite.TokensForIdentifier("name"), Value: hclwrite.TokensForValue(cty.StringVal(secretFile.Name.ValueString()))},
+			}))
+		}
+		body.SetAttributeRaw("secret_files", hclwrite.TokensForTuple(secretFiles))
+	}
+}
+
+// setAttribute sets the attribute unless value is null, which marks values
+// that are left out of the configuration.
+func setAttribute(body *hclwrite.Body, name string, value cty.Value) {
+	if value.IsNull() {
+		return
+	}
+	body.SetAttributeValue(name, value)
+}
+
+func objectValue(attributes map[string]cty.Value) cty.Value {
+	for name, value := range attributes {
+		if value.IsNull() {
+			delete(attributes, name)
+		}
+	}
+	return cty.ObjectVal(attributes)
+}
+
+// stringValue leaves out empty strings, Render returns them for attributes
+// that are not set.
+func stringValue(s types.String) cty.Value {
+	if s.IsNull() || s.IsUnknown() || s.ValueString() == "" {
+		return cty.NilVal
+	}
+	return cty.StringVal(s.ValueString())
+}
+
+func stringsValue(list []types.String) cty.Value {
+	if len(list) == 0 {
+		return cty.ListValEmpty(cty.String)
+	}
+	values := []cty.Value{}
+	for _, s := range list {
+		values = append(values, cty.StringVal(s.ValueString()))
+	}
+	return cty.ListVal(values)
+}
+
+func int64Value(i types.Int64) cty.Value {
+	if i.IsNull() || i.IsUnknown() {
+		return cty.NilVal
+	}
+	return cty.NumberIntVal(i.ValueInt64())
+}
+
+func boolValue(b types.Bool) cty.Value {
+	if b.IsNull() || b.IsUnknown() {
+		return cty.NilVal
+	}
+	return cty.BoolVal(b.ValueBool())
+}
+
+// resourceName turns a service name into a unique Terraform resource name.
+func resourceName(serviceName string, used map[string]bool) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(serviceName) {
+		if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '_' || r == '-' {
+			b.WriteRune(r)
+		} else {
+			b.WriteRune('_')
+		}
+	}
+	name := b.String()
+	if name == "" || name[0] >= '0' && name[0] <= '9' || name[0] == '-' {
+		name = "service_" + name
+	}
+
+	unique := name
+	for i := 2; used[unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", name, i)
+	}
+	used[unique] = true
+	return unique
+}
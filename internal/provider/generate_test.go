@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/sonlir/render-client-go"
+
+	"terraform-provider-render/internal/api"
+)
+
+func TestGenerateConfig(t *testing.T) {
+	responses := map[string]string{
+		"/services": `[
+			{"cursor": "c1", "service": {"id": "srv-2", "name": "2 API", "ownerId": "tea-1", "type": "web_service"}},
+			{"cursor": "c2", "service": {"id": "srv-1", "name": "web", "ownerId": "tea-1", "type": "web_service"}}
+		]`,
+		"/services/srv-1": `{
+			"id": "srv-1", "name": "web", "ownerId": "tea-1", "repo": "https://github.com/example/web", "branch": "main", "autoDeploy": "yes",
+			"buildFilter": {"paths": ["src/**"]},
+			"serviceDetails": {"env": "node", "plan": "starter", "region": "oregon", "numInstances": 1, "pullRequestPreviewsEnabled": "no",
+				"envSpecificDetails": {"buildCommand": "npm ci", "startCommand": "npm start"}}
+		}`,
+		"/services/srv-1/env-vars":     `[{"envVar": {"key": "B", "value": "2"}}, {"envVar": {"key": "A", "value": "1"}}]`,
+		"/services/srv-1/secret-files": `[{"secretFile": {"name": ".npmrc", "content": "token"}}]`,
+		"/services/srv-2": `{
+			"id": "srv-2", "name": "2 API", "ownerId": "tea-1", "imagePath": "docker.io/library/nginx:latest",
+			"serviceDetails": {"env": "image", "plan": "starter", "region": "frankfurt", "numInstances": 2}
+		}`,
+		"/services/srv-2/env-vars":     `[]`,
+		"/services/srv-2/secret-files": `[]`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response, ok := responses[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(response))
+	}))
+	defer server.Close()
+
+	apiKey := "rnd_test"
+	client, err := render.NewClient(&apiKey, &server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	err = GenerateConfig(api.NewClient(client), GenerateOptions{OwnerID: "tea-1"}, &out)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `import {
+  to = render_web_service.service_2_api
+  id = "srv-2"
+}
+
+resource "render_web_service" "service_2_api" {
+  name     = "2 API"
+  owner_id = "tea-1"
+  image = {
+    image_path = "docker.io/library/nginx:latest"
+    owner_id   = "tea-1"
+  }
+  service_details = {
+    env           = "image"
+    num_instances = 2
+    plan          = "starter"
+    region        = "frankfurt"
+  }
+}
+
+import {
+  to = render_web_service.web
+  id = "srv-1"
+}
+
+resource "render_web_service" "web" {
+  name        = "web"
+  owner_id    = "tea-1"
+  repo        = "https://github.com/example/web"
+  branch      = "main"
+  auto_deploy = "yes"
+  build_filter = {
+    ignored_paths = []
+    paths         = ["src/**"]
+  }
+  service_details = {
+    env = "node"
+    native_environment_details = {
+      build_command = "npm ci"
+      start_command = "npm start"
+    }
+    num_instances                 = 1
+    plan                          = "starter"
+    pull_request_previews_enabled = "no"
+    region                        = "oregon"
+  }
+  environment_variables = {
+    A = {
+      ignore_value = true
+    }
+    B = {
+      ignore_value = true
+    }
+  }
+  secret_files = [{
+    content = file("secrets/web/.npmrc")
+    name    = ".npmrc"
+  }]
+}
+`
+	if out.String() != expected {
+		t.Errorf("unexpected configuration:\n%s", out.String())
+	}
+
+	out.Reset()
+	err = GenerateConfig(api.NewClient(client), GenerateOptions{OwnerID: "tea-1", IncludeSecrets: true}, &out)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedSecrets := `  environment_variables = {
+    A = {
+      value = "1"
+    }
+    B = {
+      value = "2"
+    }
+  }
+  secret_files = [{
+    content = "token"
+    name    = ".npmrc"
+  }]
+`
+	if !strings.Contains(out.String(), expectedSecrets) {
+		t.Errorf("expected the values of the secrets, got:\n%s", out.String())
+	}
+}
+
+func TestResourceName(t *testing.T) {
+	used := map[string]bool{}
+	tests := []struct {
+		serviceName string
+		expected    string
+	}{
+		{"web", "web"},
+		{"My API", "my_api"},
+		{"my.api", "my_api_2"},
+		{"2-workers", "service_2-workers"},
+		{"Ünïcode", "_n_code"},
+	}
+	for _, test := range tests {
+		if name := resourceName(test.serviceName, used); name != test.expected {
+			t.Errorf("expected %q for %q, got: %q", test.expected, test.serviceName, name)
+		}
+	}
+}
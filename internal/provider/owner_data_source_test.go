@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestOwnerDataSource(t *testing.T) {
+	_, providerConfig := newTestServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+data "render_owner" "test" {
+  id = %q
+}
+`, testOwnerID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.render_owner.test", "id", testOwnerID),
+					resource.TestCheckResourceAttr("data.render_owner.test", "name", "Jane Doe"),
+					resource.TestCheckResourceAttr("data.render_owner.test", "email", "jane@example.com"),
+					resource.TestCheckResourceAttr("data.render_owner.test", "type", "user"),
+				),
+			},
+			{
+				Config: providerConfig + `
+data "render_owner" "test" {
+  id = "usr-missing"
+}
+`,
+				ExpectError: regexp.MustCompile("Unable to Read Render Owner"),
+			},
+		},
+	})
+}
@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/sonlir/render-client-go"
+)
+
+func TestOwnersDataSource(t *testing.T) {
+	server, providerConfig := newTestServer(t)
+	server.AddOwner(render.Owner{ID: "tea-test", Name: "My Team", Email: "team@example.com", Type: "team"})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "render_owners" "all" {}
+
+data "render_owners" "team" {
+  email = "team@example.com"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.render_owners.all", "owners.#", "2"),
+					resource.TestCheckResourceAttr("data.render_owners.team", "owners.#", "1"),
+					resource.TestCheckResourceAttr("data.render_owners.team", "owners.0.id", "tea-test"),
+					resource.TestCheckResourceAttr("data.render_owners.team", "owners.0.name", "My Team"),
+					resource.TestCheckResourceAttr("data.render_owners.team", "owners.0.type", "team"),
+				),
+			},
+		},
+	})
+}
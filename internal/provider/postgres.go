@@ -0,0 +1,408 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sonlir/render-client-go"
+
+	"terraform-provider-render/internal/api"
+)
+
+var (
+	_ resource.Resource                = &Postgres{}
+	_ resource.ResourceWithConfigure   = &Postgres{}
+	_ resource.ResourceWithImportState = &Postgres{}
+)
+
+func NewPostgres() resource.Resource {
+	return &Postgres{}
+}
+
+type Postgres struct {
+	client *api.Client
+}
+
+type PostgresModel struct {
+	ID                       types.String          `tfsdk:"id"`
+	Name                     types.String          `tfsdk:"name"`
+	OwnerID                  types.String          `tfsdk:"owner_id"`
+	Plan                     types.String          `tfsdk:"plan"`
+	Region                   types.String          `tfsdk:"region"`
+	Version                  types.String          `tfsdk:"version"`
+	DatabaseName             types.String          `tfsdk:"database_name"`
+	DatabaseUser             types.String          `tfsdk:"database_user"`
+	HighAvailabilityEnabled  types.Bool            `tfsdk:"high_availability_enabled"`
+	IPAllowList              []IPAllowListEntry    `tfsdk:"ip_allow_list"`
+	ReadReplicas             []PostgresReadReplica `tfsdk:"read_replicas"`
+	Status                   types.String          `tfsdk:"status"`
+	CreatedAt                types.String          `tfsdk:"created_at"`
+	UpdatedAt                types.String          `tfsdk:"updated_at"`
+	InternalConnectionString types.String          `tfsdk:"internal_connection_string"`
+	ExternalConnectionString types.String          `tfsdk:"external_connection_string"`
+}
+
+type PostgresReadReplica struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+var postgresReadReplicaType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"id":   types.StringType,
+	"name": types.StringType,
+}}
+
+func (r *Postgres) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_postgres"
+}
+
+func (r *Postgres) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Creates a new Render Postgres database owned by you or a team you belong to.\n~> **Note:** Connection strings contain the database password and are stored in the Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the database",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the database",
+				Required:            true,
+			},
+			"owner_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the owner of the database",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"plan": schema.StringAttribute{
+				MarkdownDescription: "The plan for the database, e.g. `starter`, `standard`, `pro`, `pro_plus`. Default: `starter`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The region for the database. Valid values are `oregon`, `ohio`, `virginia`, `frankfurt`, `singapore`. Defaults to `oregon`. Changing this forces a new database.",
+				Optional:            true,
+				Computed:            true,
+				Validators:          []validator.String{stringvalidator.OneOf(regions...)},
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "The major PostgreSQL version, e.g. `16`. Defaults to the latest version supported by Render. Changing this forces a new database.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
+			},
+			"database_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the database inside the instance. Generated by Render if left empty. Changing this forces a new database.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
+			},
+			"database_user": schema.StringAttribute{
+				MarkdownDescription: "The name of the database user. Generated by Render if left empty. Changing this forces a new database.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
+			},
+			"high_availability_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether a standby instance is kept in sync for automatic failover. Only available on `pro` plans and above.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"ip_allow_list": schema.ListNestedAttribute{
+				MarkdownDescription: "The CIDR blocks allowed to connect to the database from outside of Render. An empty list blocks all external connections. Default: `[]`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(types.ListValueMust(ipAllowListEntryType, []attr.Value{})),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cidr_block": schema.StringAttribute{
+							MarkdownDescription: "The CIDR block, e.g. `203.0.113.0/24`",
+							Required:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the entry",
+							Required:            true,
+						},
+					},
+				},
+			},
+			"read_replicas": schema.ListNestedAttribute{
+				MarkdownDescription: "The read replicas of the database. Default: `[]`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(types.ListValueMust(postgresReadReplicaType, []attr.Value{})),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the read replica",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the read replica",
+							Required:            true,
+						},
+					},
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the database, e.g. `creating` or `available`",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The date and time the database was created",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "The date and time the database was last updated",
+				Computed:            true,
+			},
+			"internal_connection_string": schema.StringAttribute{
+				MarkdownDescription: "The connection string for services in the same region",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"external_connection_string": schema.StringAttribute{
+				MarkdownDescription: "The connection string for clients outside of Render. Subject to `ip_allow_list`.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+func (r *Postgres) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*render.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = api.NewClient(client)
+}
+
+func (r *Postgres) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan PostgresModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	postgres, err := r.client.CreatePostgres(makePostgresData(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Render postgres",
+			"Could not create postgres, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	connectionInfo, err := r.client.GetPostgresConnectionInfo(postgres.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Render postgres",
+			"Could not get connection info for postgres ID: "+postgres.ID+": "+err.Error(),
+		)
+		return
+	}
+
+	makePostgresModel(&plan, postgres, connectionInfo)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *Postgres) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state PostgresModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	postgres, err := r.client.GetPostgres(state.ID.ValueString())
+	if api.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not get Render postgres: "+state.ID.ValueString(),
+			err.Error(),
+		)
+		return
+	}
+
+	connectionInfo, err := r.client.GetPostgresConnectionInfo(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not get Render postgres connection info: "+state.ID.ValueString(),
+			err.Error(),
+		)
+		return
+	}
+
+	makePostgresModel(&state, postgres, connectionInfo)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *Postgres) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state PostgresModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+
+	postgres, err := r.client.UpdatePostgres(plan.ID.ValueString(), makePostgresData(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Render postgres",
+			"Could not update postgres ID: "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	connectionInfo, err := r.client.GetPostgresConnectionInfo(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Render postgres",
+			"Could not get connection info for postgres ID: "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	makePostgresModel(&plan, postgres, connectionInfo)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *Postgres) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state PostgresModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeletePostgres(state.ID.ValueString())
+	if err != nil && !api.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting Render postgres",
+			"Could not delete postgres ID: "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *Postgres) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func makePostgresModel(state *PostgresModel, postgres *api.Postgres, connectionInfo *api.PostgresConnectionInfo) {
+	state.ID = types.StringValue(postgres.ID)
+	state.Name = types.StringValue(postgres.Name)
+	if postgres.Owner != nil {
+		state.OwnerID = types.StringValue(postgres.Owner.ID)
+	}
+	state.Plan = types.StringValue(postgres.Plan)
+	state.Region = types.StringValue(postgres.Region)
+	state.Version = types.StringValue(postgres.Version)
+	state.DatabaseName = types.StringValue(postgres.DatabaseName)
+	state.DatabaseUser = types.StringValue(postgres.DatabaseUser)
+	state.HighAvailabilityEnabled = types.BoolValue(postgres.HighAvailabilityEnabled)
+	state.IPAllowList = []IPAllowListEntry{}
+	for _, entry := range postgres.IPAllowList {
+		state.IPAllowList = append(state.IPAllowList, IPAllowListEntry{
+			CIDRBlock:   types.StringValue(entry.CIDRBlock),
+			Description: types.StringValue(entry.Description),
+		})
+	}
+	state.ReadReplicas = []PostgresReadReplica{}
+	for _, replica := range postgres.ReadReplicas {
+		state.ReadReplicas = append(state.ReadReplicas, PostgresReadReplica{
+			ID:   types.StringValue(replica.ID),
+			Name: types.StringValue(replica.Name),
+		})
+	}
+	state.Status = types.StringValue(postgres.Status)
+	state.CreatedAt = types.StringValue(postgres.CreatedAt)
+	state.UpdatedAt = types.StringValue(postgres.UpdatedAt)
+	state.InternalConnectionString = types.StringValue(connectionInfo.InternalConnectionString)
+	state.ExternalConnectionString = types.StringValue(connectionInfo.ExternalConnectionString)
+}
+
+func makePostgresData(plan *PostgresModel) api.PostgresData {
+	data := api.PostgresData{
+		DatabaseName:           plan.DatabaseName.ValueString(),
+		DatabaseUser:           plan.DatabaseUser.ValueString(),
+		EnableHighAvailability: plan.HighAvailabilityEnabled.ValueBool(),
+		IPAllowList:            []api.IPAllowListEntry{},
+		Name:                   plan.Name.ValueString(),
+		OwnerID:                plan.OwnerID.ValueString(),
+		Plan:                   plan.Plan.ValueString(),
+		ReadReplicas:           []api.PostgresReadReplica{},
+		Region:                 plan.Region.ValueString(),
+		Version:                plan.Version.ValueString(),
+	}
+
+	for _, entry := range plan.IPAllowList {
+		data.IPAllowList = append(data.IPAllowList, api.IPAllowListEntry{
+			CIDRBlock:   entry.CIDRBlock.ValueString(),
+			Description: entry.Description.ValueString(),
+		})
+	}
+
+	for _, replica := range plan.ReadReplicas {
+		data.ReadReplicas = append(data.ReadReplicas, api.PostgresReadReplica{
+			Name: replica.Name.ValueString(),
+		})
+	}
+
+	return data
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"terraform-provider-render/internal/fakerender"
+)
+
+func TestPostgresResource(t *testing.T) {
+	server, providerConfig := newTestServer(t)
+
+	var id string
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckPostgresDestroy(server),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testPostgresConfig("my-db", "starter", false, "replica-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("render_postgres.test", "id", func(value string) error {
+						id = value
+						return nil
+					}),
+					resource.TestCheckResourceAttr("render_postgres.test", "name", "my-db"),
+					resource.TestCheckResourceAttr("render_postgres.test", "plan", "starter"),
+					resource.TestCheckResourceAttr("render_postgres.test", "region", "oregon"),
+					resource.TestCheckResourceAttr("render_postgres.test", "version", "16"),
+					resource.TestCheckResourceAttr("render_postgres.test", "database_name", "my_db"),
+					resource.TestCheckResourceAttr("render_postgres.test", "database_user", "my_db_user"),
+					resource.TestCheckResourceAttr("render_postgres.test", "high_availability_enabled", "false"),
+					resource.TestCheckResourceAttr("render_postgres.test", "ip_allow_list.#", "1"),
+					resource.TestCheckResourceAttr("render_postgres.test", "ip_allow_list.0.cidr_block", "203.0.113.0/24"),
+					resource.TestCheckResourceAttr("render_postgres.test", "read_replicas.#", "1"),
+					resource.TestCheckResourceAttr("render_postgres.test", "read_replicas.0.name", "replica-1"),
+					resource.TestCheckResourceAttrSet("render_postgres.test", "read_replicas.0.id"),
+					resource.TestCheckResourceAttr("render_postgres.test", "status", "available"),
+					resource.TestCheckResourceAttrSet("render_postgres.test", "internal_connection_string"),
+					resource.TestCheckResourceAttrSet("render_postgres.test", "external_connection_string"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "render_postgres.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testPostgresConfig("my-db-renamed", "pro", true, "replica-2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("render_postgres.test", "id", &id),
+					resource.TestCheckResourceAttr("render_postgres.test", "name", "my-db-renamed"),
+					resource.TestCheckResourceAttr("render_postgres.test", "plan", "pro"),
+					resource.TestCheckResourceAttr("render_postgres.test", "high_availability_enabled", "true"),
+					resource.TestCheckResourceAttr("render_postgres.test", "read_replicas.0.name", "replica-2"),
+				),
+			},
+		},
+	})
+}
+
+func testPostgresConfig(name, plan string, highAvailability bool, readReplica string) string {
+	return fmt.Sprintf(`
+resource "render_postgres" "test" {
+  name                      = %q
+  owner_id                  = %q
+  plan                      = %q
+  high_availability_enabled = %t
+
+  ip_allow_list = [
+    { cidr_block = "203.0.113.0/24", description = "office" },
+  ]
+
+  read_replicas = [
+    { name = %q },
+  ]
+}
+`, name, testOwnerID, plan, highAvailability, readReplica)
+}
+
+func testCheckPostgresDestroy(server *fakerender.Server) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if ids := server.PostgresIDs(); len(ids) > 0 {
+			return fmt.Errorf("postgres instances still exist: %v", ids)
+		}
+		return nil
+	}
+}
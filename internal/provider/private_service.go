@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sonlir/render-client-go"
+)
+
+var (
+	_ resource.Resource                   = &PrivateService{}
+	_ resource.ResourceWithConfigure      = &PrivateService{}
+	_ resource.ResourceWithImportState    = &PrivateService{}
+	_ resource.ResourceWithUpgradeState   = &PrivateService{}
+	_ resource.ResourceWithValidateConfig = &PrivateService{}
+	_ resource.ResourceWithModifyPlan     = &PrivateService{}
+)
+
+func NewPrivateService() resource.Resource {
+	return &PrivateService{serviceResource[PrivateServiceDetails]{conversion: privateServiceConversion}}
+}
+
+type PrivateService struct {
+	serviceResource[PrivateServiceDetails]
+}
+
+type PrivateServiceModel = ServiceResourceModel[PrivateServiceDetails]
+
+type PrivateServiceDetails struct {
+	Autoscaling                *Autoscaling              `tfsdk:"autoscaling"`
+	Disk                       *Disk                     `tfsdk:"disk"`
+	Env                        types.String              `tfsdk:"env"`
+	DockerDetails              *DockerDetails            `tfsdk:"docker_details"`
+	NativeEnvironmentDetails   *NativeEnvironmentDetails `tfsdk:"native_environment_details"`
+	NumInstances               types.Int64               `tfsdk:"num_instances"`
+	Plan                       types.String              `tfsdk:"plan"`
+	PullRequestPreviewsEnabled types.String              `tfsdk:"pull_request_previews_enabled"`
+	Region                     types.String              `tfsdk:"region"`
+	OpenPorts                  types.List                `tfsdk:"open_ports"`
+	ParentServer               types.Object              `tfsdk:"parent_server"`
+	URL                        types.String              `tfsdk:"url"`
+}
+
+var privateServiceConversion = serviceConversion[PrivateServiceDetails]{
+	serviceType:      "private_service",
+	makeDetailsModel: makePrivateServiceDetailsModel,
+	makeDetailsData:  makePrivateServiceDetailsData,
+}
+
+func (r *PrivateService) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	serviceDetails := scalableServiceDetailsAttributes()
+	serviceDetails["open_ports"] = openPortsAttribute()
+	serviceDetails["url"] = schema.StringAttribute{
+		MarkdownDescription: "The internal address of the service",
+		Computed:            true,
+		PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+	}
+
+	resp.Schema = serviceResourceSchema(ctx, "Creates a new Render private service owned by you or a team you belong to. Private services are reachable only from other services in the same region and are not exposed to the public internet.", serviceDetails)
+}
+
+func makePrivateServiceDetailsModel(current *PrivateServiceDetails, imported bool, serviceDetails render.ServiceDetails) *PrivateServiceDetails {
+	privateServiceDetails := &PrivateServiceDetails{
+		Autoscaling:                makeAutoscalingModel(serviceDetails.Autoscaling),
+		Disk:                       makeServiceDiskModel(current.Disk, imported, serviceDetails.Disk),
+		Env:                        types.StringValue(serviceDetails.Env),
+		NumInstances:               types.Int64Value(serviceDetails.NumInstances),
+		Plan:                       types.StringValue(serviceDetails.Plan),
+		PullRequestPreviewsEnabled: types.StringValue(serviceDetails.PullRequestPreviewsEnabled),
+		Region:                     types.StringValue(serviceDetails.Region),
+		OpenPorts:                  openPortsValue(serviceDetails.OpenPorts),
+		ParentServer:               parentServerValue(serviceDetails.ParentServer),
+		URL:                        types.StringValue(serviceDetails.URL),
+	}
+	privateServiceDetails.DockerDetails, privateServiceDetails.NativeEnvironmentDetails = makeEnvSpecificDetailsResourceModel(current.DockerDetails, current.NativeEnvironmentDetails, imported, serviceDetails)
+	return privateServiceDetails
+}
+
+func makePrivateServiceDetailsData(privateServiceDetails *PrivateServiceDetails) render.ServiceDetails {
+	return render.ServiceDetails{
+		Autoscaling: makeAutoscalingData(privateServiceDetails.Autoscaling),
+		Disk:        makeServiceDiskData(privateServiceDetails.Disk),
+		Env:         privateServiceDetails.Env.ValueString(),
+		// ValidateConfig makes sure that only the block matching the runtime is set.
+		EnvSpecificDetails:         makeEnvSpecificDetailsData(privateServiceDetails.Env, privateServiceDetails.DockerDetails, privateServiceDetails.NativeEnvironmentDetails),
+		NumInstances:               privateServiceDetails.NumInstances.ValueInt64(),
+		Plan:                       privateServiceDetails.Plan.ValueString(),
+		PullRequestPreviewsEnabled: privateServiceDetails.PullRequestPreviewsEnabled.ValueString(),
+		Region:                     privateServiceDetails.Region.ValueString(),
+	}
+}
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestPrivateServiceDataSource(t *testing.T) {
+	_, providerConfig := newTestServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testPrivateServiceConfig("my-backend", "docker", "starter", 1) + `
+data "render_private_service" "test" {
+  id = render_private_service.test.id
+}
+
+data "render_private_services" "test" {
+  name = render_private_service.test.name
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.render_private_service.test", "id", "render_private_service.test", "id"),
+					resource.TestCheckResourceAttr("data.render_private_service.test", "name", "my-backend"),
+					resource.TestCheckResourceAttr("data.render_private_service.test", "type", "private_service"),
+					resource.TestCheckResourceAttr("data.render_private_service.test", "service_details.docker_details.dockerfile_path", "./Dockerfile.prod"),
+					resource.TestCheckResourceAttr("data.render_private_service.test", "environment_variables.%", "1"),
+					resource.TestCheckResourceAttr("data.render_private_services.test", "private_services.#", "1"),
+					resource.TestCheckResourceAttrPair("data.render_private_services.test", "private_services.0.id", "render_private_service.test", "id"),
+				),
+			},
+		},
+	})
+}
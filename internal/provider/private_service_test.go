@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/sonlir/render-client-go"
+)
+
+func TestPrivateServiceResource(t *testing.T) {
+	server, providerConfig := newTestServer(t)
+
+	var id string
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckServiceDestroy(server, "render_private_service"),
+		Steps: []resource.TestStep{
+			// Only the block matching the runtime can be set
+			{
+				Config:      providerConfig + testPrivateServiceConfig("my-backend", "node", "starter", 1),
+				ExpectError: regexp.MustCompile("Invalid Environment Details"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + testPrivateServiceConfig("my-backend", "docker", "starter", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("render_private_service.test", "id", func(value string) error {
+						id = value
+						return nil
+					}),
+					resource.TestCheckResourceAttr("render_private_service.test", "name", "my-backend"),
+					resource.TestCheckResourceAttr("render_private_service.test", "type", "private_service"),
+					resource.TestCheckResourceAttr("render_private_service.test", "service_details.plan", "starter"),
+					resource.TestCheckResourceAttr("render_private_service.test", "service_details.url", "my-backend:10000"),
+					resource.TestCheckResourceAttr("render_private_service.test", "service_details.docker_details.dockerfile_path", "./Dockerfile.prod"),
+					resource.TestCheckResourceAttr("render_private_service.test", "service_details.docker_details.docker_context", "."),
+					resource.TestCheckNoResourceAttr("render_private_service.test", "service_details.native_environment_details"),
+					resource.TestCheckNoResourceAttr("render_private_service.test", "service_details.parent_server"),
+					resource.TestCheckResourceAttr("render_private_service.test", "environment_variables.PORT.value", "10000"),
+					resource.TestCheckResourceAttr("render_private_service.test", "secret_files.#", "1"),
+					resource.TestCheckResourceAttr("render_private_service.test", "secret_files.0.content", "s3cr3t"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "render_private_service.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testPrivateServiceConfig("my-backend-renamed", "docker", "standard", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("render_private_service.test", "id", &id),
+					resource.TestCheckResourceAttr("render_private_service.test", "name", "my-backend-renamed"),
+					resource.TestCheckResourceAttr("render_private_service.test", "service_details.plan", "standard"),
+					resource.TestCheckResourceAttr("render_private_service.test", "service_details.num_instances", "2"),
+					resource.TestCheckResourceAttr("render_private_service.test", "secret_files.0.content", "s3cr3t"),
+					testCheckService(server, "render_private_service.test", func(service render.Service) error {
+						if service.ServiceDetails.Plan != "standard" || service.ServiceDetails.NumInstances != 2 {
+							return fmt.Errorf("private service was not updated: %+v", service.ServiceDetails)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+// testPrivateServiceConfig returns a private service using the docker runtime
+// details, which is only valid when env is docker.
+func testPrivateServiceConfig(name, env, plan string, numInstances int) string {
+	return fmt.Sprintf(`
+resource "render_private_service" "test" {
+  name     = %q
+  owner_id = %q
+  repo     = "https://github.com/render-examples/express-hello-world"
+
+  service_details = {
+    env           = %q
+    plan          = %q
+    num_instances = %d
+    docker_details = {
+      dockerfile_path = "./Dockerfile.prod"
+    }
+  }
+
+  environment_variables = {
+    PORT = { value = "10000" }
+  }
+
+  secret_files = [
+    { name = "secret.txt", content = "s3cr3t" },
+  ]
+}
+`, name, testOwnerID, env, plan, numInstances)
+}
@@ -2,17 +2,24 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/sonlir/render-client-go"
+
+	"terraform-provider-render/internal/api"
 )
 
 var _ provider.Provider = &RenderProvider{}
@@ -23,9 +30,21 @@ type RenderProvider struct {
 }
 
 type RenderProviderModel struct {
-	APIKey types.String `tfsdk:"api_key"`
+	APIKey         types.String `tfsdk:"api_key"`
+	APIURL         types.String `tfsdk:"api_url"`
+	RequestTimeout types.String `tfsdk:"request_timeout"`
+	MaxRetries     types.Int64  `tfsdk:"max_retries"`
+	MaxRetryWait   types.String `tfsdk:"max_retry_wait"`
+	MaxRequests    types.Int64  `tfsdk:"max_requests_per_minute"`
 }
 
+const (
+	// defaultRequestTimeout matches the timeout of the upstream client.
+	defaultRequestTimeout = 10 * time.Second
+	defaultMaxRetries     = 3
+	defaultMaxRetryWait   = 30 * time.Second
+)
+
 func (p *RenderProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "render"
 	resp.Version = p.version
@@ -39,6 +58,30 @@ func (p *RenderProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"api_url": schema.StringAttribute{
+				MarkdownDescription: "The base URL of the Render API. May also be provided via `RENDER_API_URL` environment variable. Default: `" + render.HostURL + "`.",
+				Optional:            true,
+			},
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: "The time limit for a single attempt of an API request, as a duration such as `30s` or `1m`. Default: `10s`.",
+				Optional:            true,
+				Validators:          []validator.String{durationValidator{}},
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "How many times a request is retried when it is rate limited, or when a safe to repeat request fails with a server or network error. Set to `0` to disable retries. Default: `3`.",
+				Optional:            true,
+				Validators:          []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"max_retry_wait": schema.StringAttribute{
+				MarkdownDescription: "The longest time to wait before a single retry, as a duration such as `30s` or `1m`. Retries back off exponentially with jitter unless the API asks to wait for a specific time. When the API asks to wait longer than this, the request fails instead of being retried. Default: `30s`.",
+				Optional:            true,
+				Validators:          []validator.String{durationValidator{}},
+			},
+			"max_requests_per_minute": schema.Int64Attribute{
+				MarkdownDescription: "The most API requests the provider sends per minute, across all resources and data sources. Requests over the limit wait for their turn. Set this below your account's rate limit to keep large applies from being throttled. Default: no limit.",
+				Optional:            true,
+				Validators:          []validator.Int64{int64validator.AtLeast(1)},
+			},
 		},
 	}
 }
@@ -87,7 +130,48 @@ func (p *RenderProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
-	client, err := render.NewClient(&apiKey, nil)
+	if config.APIURL.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_url"),
+			"Unknown Render API URL",
+			"The provider cannot create the Render API client as there is an unknown configuration value for the Render API URL. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the RENDER_API_URL environment variable.",
+		)
+		return
+	}
+
+	apiURL := render.HostURL
+	if v := os.Getenv("RENDER_API_URL"); v != "" {
+		apiURL = v
+	}
+	if !config.APIURL.IsNull() {
+		apiURL = config.APIURL.ValueString()
+	}
+	apiURL = strings.TrimSuffix(apiURL, "/")
+
+	requestTimeout := defaultRequestTimeout
+	if !config.RequestTimeout.IsNull() && !config.RequestTimeout.IsUnknown() {
+		// durationValidator has already checked the value.
+		requestTimeout, _ = time.ParseDuration(config.RequestTimeout.ValueString())
+	}
+
+	maxRetries := defaultMaxRetries
+	if !config.MaxRetries.IsNull() && !config.MaxRetries.IsUnknown() {
+		maxRetries = int(config.MaxRetries.ValueInt64())
+	}
+
+	maxRetryWait := defaultMaxRetryWait
+	if !config.MaxRetryWait.IsNull() && !config.MaxRetryWait.IsUnknown() {
+		// durationValidator has already checked the value.
+		maxRetryWait, _ = time.ParseDuration(config.MaxRetryWait.ValueString())
+	}
+
+	requestsPerMinute := 0
+	if !config.MaxRequests.IsNull() && !config.MaxRequests.IsUnknown() {
+		requestsPerMinute = int(config.MaxRequests.ValueInt64())
+	}
+
+	client, err := render.NewClient(&apiKey, &apiURL)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create Render API Client",
@@ -98,21 +182,47 @@ func (p *RenderProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
+	client.HTTPClient = api.NewHTTPClient(api.HTTPClientOptions{
+		Timeout:           requestTimeout,
+		UserAgent:         fmt.Sprintf("Terraform/%s terraform-provider-render/%s", req.TerraformVersion, p.version),
+		MaxRetries:        maxRetries,
+		MaxRetryWait:      maxRetryWait,
+		RequestsPerMinute: requestsPerMinute,
+	})
+
 	resp.DataSourceData = client
 	resp.ResourceData = client
 }
 
 func (p *RenderProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewBackgroundWorker,
+		NewCronJob,
+		NewCustomDomain,
+		NewDeploy,
+		NewDisk,
+		NewEnvGroup,
+		NewEnvGroupLink,
+		NewPostgres,
+		NewPrivateService,
+		NewRedis,
 		NewRegistryCredential,
+		NewServiceEnvVar,
+		NewServiceSecretFile,
+		NewStaticSite,
 		NewWebService,
 	}
 }
 
 func (p *RenderProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewBackgroundWorkerDataSource,
+		NewBackgroundWorkersDataSource,
+		NewDiskSnapshotsDataSource,
 		NewOwnerDataSource,
 		NewOwnersDataSource,
+		NewPrivateServiceDataSource,
+		NewPrivateServicesDataSource,
 		NewRegistryCredentialDataSource,
 		NewRegistryCredentialsDataSource,
 		NewWebServiceDataSource,
@@ -1,16 +1,46 @@
 package provider
 
 import (
+	"fmt"
+	"os"
+	"os/exec"
+	"testing"
+
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
-)
+	"github.com/sonlir/render-client-go"
 
-const (
-	providerConfig = `
-  provider "render" {}
-  `
+	"terraform-provider-render/internal/fakerender"
 )
 
+// testOwnerID is the owner that newTestServer adds to the fake API.
+const testOwnerID = "usr-test"
+
 var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
 	"render": providerserver.NewProtocol6WithError(New("test")()),
 }
+
+// newTestServer starts a fake Render API for a unit test, and returns it with
+// a provider block pointing at it. The test is skipped when the Terraform CLI
+// is not available, as the test framework would try to download it, unless
+// TF_ACC is set as it is in CI.
+func newTestServer(t *testing.T) (*fakerender.Server, string) {
+	t.Helper()
+	if os.Getenv("TF_ACC") == "" && os.Getenv("TF_ACC_TERRAFORM_PATH") == "" {
+		if _, err := exec.LookPath("terraform"); err != nil {
+			t.Skip("Terraform CLI not found, add it to PATH or set TF_ACC_TERRAFORM_PATH")
+		}
+	}
+
+	server := fakerender.NewServer()
+	t.Cleanup(server.Close)
+	server.AddOwner(render.Owner{ID: testOwnerID, Name: "Jane Doe", Email: "jane@example.com", Type: "user"})
+
+	return server, fmt.Sprintf(`
+provider "render" {
+  api_key     = %q
+  api_url     = %q
+  max_retries = 0
+}
+`, fakerender.APIKey, server.URL)
+}
@@ -0,0 +1,338 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sonlir/render-client-go"
+
+	"terraform-provider-render/internal/api"
+)
+
+var (
+	_ resource.Resource                = &Redis{}
+	_ resource.ResourceWithConfigure   = &Redis{}
+	_ resource.ResourceWithImportState = &Redis{}
+)
+
+func NewRedis() resource.Resource {
+	return &Redis{}
+}
+
+type Redis struct {
+	client *api.Client
+}
+
+type RedisModel struct {
+	ID                       types.String       `tfsdk:"id"`
+	Name                     types.String       `tfsdk:"name"`
+	OwnerID                  types.String       `tfsdk:"owner_id"`
+	Plan                     types.String       `tfsdk:"plan"`
+	Region                   types.String       `tfsdk:"region"`
+	MaxmemoryPolicy          types.String       `tfsdk:"maxmemory_policy"`
+	IPAllowList              []IPAllowListEntry `tfsdk:"ip_allow_list"`
+	Status                   types.String       `tfsdk:"status"`
+	CreatedAt                types.String       `tfsdk:"created_at"`
+	UpdatedAt                types.String       `tfsdk:"updated_at"`
+	InternalConnectionString types.String       `tfsdk:"internal_connection_string"`
+	ExternalConnectionString types.String       `tfsdk:"external_connection_string"`
+}
+
+func (r *Redis) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_redis"
+}
+
+func (r *Redis) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Creates a new Render Key Value (Redis) instance owned by you or a team you belong to.\n~> **Note:** Connection strings contain the instance password and are stored in the Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the instance",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the instance",
+				Required:            true,
+			},
+			"owner_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the owner of the instance",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"plan": schema.StringAttribute{
+				MarkdownDescription: "The plan for the instance, e.g. `starter`, `standard`, `pro`, `pro_plus`. Default: `starter`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The region for the instance. Valid values are `oregon`, `ohio`, `virginia`, `frankfurt`, `singapore`. Defaults to `oregon`. Changing this forces a new instance.",
+				Optional:            true,
+				Computed:            true,
+				Validators:          []validator.String{stringvalidator.OneOf(regions...)},
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
+			},
+			"maxmemory_policy": schema.StringAttribute{
+				MarkdownDescription: "The eviction policy used when the instance reaches its memory limit. Valid values are `allkeys_lru`, `allkeys_lfu`, `allkeys_random`, `volatile_lru`, `volatile_lfu`, `volatile_random`, `volatile_ttl`, `noeviction`. Default: `allkeys_lru`.",
+				Optional:            true,
+				Computed:            true,
+				Validators:          []validator.String{stringvalidator.OneOf("allkeys_lru", "allkeys_lfu", "allkeys_random", "volatile_lru", "volatile_lfu", "volatile_random", "volatile_ttl", "noeviction")},
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"ip_allow_list": schema.ListNestedAttribute{
+				MarkdownDescription: "The CIDR blocks allowed to connect to the instance from outside of Render. An empty list blocks all external connections. Default: `[]`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(types.ListValueMust(ipAllowListEntryType, []attr.Value{})),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cidr_block": schema.StringAttribute{
+							MarkdownDescription: "The CIDR block, e.g. `203.0.113.0/24`",
+							Required:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the entry",
+							Required:            true,
+						},
+					},
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the instance, e.g. `creating` or `available`",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The date and time the instance was created",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "The date and time the instance was last updated",
+				Computed:            true,
+			},
+			"internal_connection_string": schema.StringAttribute{
+				MarkdownDescription: "The connection string for services in the same region",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"external_connection_string": schema.StringAttribute{
+				MarkdownDescription: "The connection string for clients outside of Render. Subject to `ip_allow_list`.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+func (r *Redis) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*render.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = api.NewClient(client)
+}
+
+func (r *Redis) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan RedisModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	redis, err := r.client.CreateRedis(makeRedisData(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Render redis",
+			"Could not create redis, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	connectionInfo, err := r.client.GetRedisConnectionInfo(redis.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Render redis",
+			"Could not get connection info for redis ID: "+redis.ID+": "+err.Error(),
+		)
+		return
+	}
+
+	makeRedisModel(&plan, redis, connectionInfo)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *Redis) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RedisModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	redis, err := r.client.GetRedis(state.ID.ValueString())
+	if api.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not get Render redis: "+state.ID.ValueString(),
+			err.Error(),
+		)
+		return
+	}
+
+	connectionInfo, err := r.client.GetRedisConnectionInfo(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not get Render redis connection info: "+state.ID.ValueString(),
+			err.Error(),
+		)
+		return
+	}
+
+	makeRedisModel(&state, redis, connectionInfo)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *Redis) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state RedisModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+
+	redis, err := r.client.UpdateRedis(plan.ID.ValueString(), makeRedisData(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Render redis",
+			"Could not update redis ID: "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	connectionInfo, err := r.client.GetRedisConnectionInfo(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Render redis",
+			"Could not get connection info for redis ID: "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	makeRedisModel(&plan, redis, connectionInfo)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *Redis) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RedisModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteRedis(state.ID.ValueString())
+	if err != nil && !api.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting Render redis",
+			"Could not delete redis ID: "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *Redis) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func makeRedisModel(state *RedisModel, redis *api.Redis, connectionInfo *api.RedisConnectionInfo) {
+	state.ID = types.StringValue(redis.ID)
+	state.Name = types.StringValue(redis.Name)
+	if redis.Owner != nil {
+		state.OwnerID = types.StringValue(redis.Owner.ID)
+	}
+	state.Plan = types.StringValue(redis.Plan)
+	state.Region = types.StringValue(redis.Region)
+	state.MaxmemoryPolicy = types.StringValue(redis.MaxmemoryPolicy)
+	state.IPAllowList = []IPAllowListEntry{}
+	for _, entry := range redis.IPAllowList {
+		state.IPAllowList = append(state.IPAllowList, IPAllowListEntry{
+			CIDRBlock:   types.StringValue(entry.CIDRBlock),
+			Description: types.StringValue(entry.Description),
+		})
+	}
+	state.Status = types.StringValue(redis.Status)
+	state.CreatedAt = types.StringValue(redis.CreatedAt)
+	state.UpdatedAt = types.StringValue(redis.UpdatedAt)
+	state.InternalConnectionString = types.StringValue(connectionInfo.InternalConnectionString)
+	state.ExternalConnectionString = types.StringValue(connectionInfo.ExternalConnectionString)
+}
+
+func makeRedisData(plan *RedisModel) api.RedisData {
+	data := api.RedisData{
+		IPAllowList:     []api.IPAllowListEntry{},
+		MaxmemoryPolicy: plan.MaxmemoryPolicy.ValueString(),
+		Name:            plan.Name.ValueString(),
+		OwnerID:         plan.OwnerID.ValueString(),
+		Plan:            plan.Plan.ValueString(),
+		Region:          plan.Region.ValueString(),
+	}
+
+	for _, entry := range plan.IPAllowList {
+		data.IPAllowList = append(data.IPAllowList, api.IPAllowListEntry{
+			CIDRBlock:   entry.CIDRBlock.ValueString(),
+			Description: entry.Description.ValueString(),
+		})
+	}
+
+	return data
+}
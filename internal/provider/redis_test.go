@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"terraform-provider-render/internal/fakerender"
+)
+
+func TestRedisResource(t *testing.T) {
+	server, providerConfig := newTestServer(t)
+
+	var id string
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckRedisDestroy(server),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testRedisConfig("my-cache", "oregon", "allkeys_lru"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("render_redis.test", "id", func(value string) error {
+						id = value
+						return nil
+					}),
+					resource.TestCheckResourceAttr("render_redis.test", "name", "my-cache"),
+					resource.TestCheckResourceAttr("render_redis.test", "plan", "starter"),
+					resource.TestCheckResourceAttr("render_redis.test", "region", "oregon"),
+					resource.TestCheckResourceAttr("render_redis.test", "maxmemory_policy", "allkeys_lru"),
+					resource.TestCheckResourceAttr("render_redis.test", "ip_allow_list.#", "0"),
+					resource.TestCheckResourceAttr("render_redis.test", "status", "available"),
+					resource.TestCheckResourceAttrSet("render_redis.test", "internal_connection_string"),
+					resource.TestCheckResourceAttrSet("render_redis.test", "external_connection_string"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "render_redis.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testRedisConfig("my-cache", "oregon", "noeviction"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("render_redis.test", "id", &id),
+					resource.TestCheckResourceAttr("render_redis.test", "maxmemory_policy", "noeviction"),
+				),
+			},
+			// Changing the region replaces the instance
+			{
+				Config: providerConfig + testRedisConfig("my-cache", "frankfurt", "noeviction"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("render_redis.test", "region", "frankfurt"),
+					resource.TestCheckResourceAttrWith("render_redis.test", "id", func(value string) error {
+						if value == id {
+							return fmt.Errorf("expected a new instance, got %s", value)
+						}
+						if ids := server.RedisIDs(); len(ids) != 1 || ids[0] != value {
+							return fmt.Errorf("expected only %s to exist, got %v", value, ids)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testRedisConfig(name, region, maxmemoryPolicy string) string {
+	return fmt.Sprintf(`
+resource "render_redis" "test" {
+  name             = %q
+  owner_id         = %q
+  region           = %q
+  maxmemory_policy = %q
+}
+`, name, testOwnerID, region, maxmemoryPolicy)
+}
+
+func testCheckRedisDestroy(server *fakerender.Server) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if ids := server.RedisIDs(); len(ids) > 0 {
+			return fmt.Errorf("redis instances still exist: %v", ids)
+		}
+		return nil
+	}
+}
@@ -4,11 +4,15 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/sonlir/render-client-go"
+
+	"terraform-provider-render/internal/api"
 )
 
 var (
@@ -22,7 +26,7 @@ func NewRegistryCredential() resource.Resource {
 }
 
 type RegistryCredential struct {
-	client *render.Client
+	client *api.Client
 }
 
 type RegistryCredentialModel struct {
@@ -53,6 +57,7 @@ func (r *RegistryCredential) Schema(_ context.Context, _ resource.SchemaRequest,
 			"registry": schema.StringAttribute{
 				MarkdownDescription: "The registry to use this credential with. Valid values are `GITHUB`, `GITLAB`, `DOCKER`.",
 				Required:            true,
+				Validators:          []validator.String{stringvalidator.OneOf("GITHUB", "GITLAB", "DOCKER")},
 			},
 			"username": schema.StringAttribute{
 				MarkdownDescription: "The username associated with the credential",
@@ -87,7 +92,7 @@ func (r *RegistryCredential) Configure(ctx context.Context, req resource.Configu
 		return
 	}
 
-	r.client = client
+	r.client = api.NewClient(client)
 }
 
 func (r *RegistryCredential) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -97,13 +102,6 @@ func (r *RegistryCredential) Create(ctx context.Context, req resource.CreateRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	if plan.Registry.ValueString() != "GITHUB" && plan.Registry.ValueString() != "GITLAB" && plan.Registry.ValueString() != "DOCKER" {
-		resp.Diagnostics.AddError(
-			"Invalid registry",
-			fmt.Sprintf("The registry value must be one of GITHUB, GITLAB, or DOCKER, got: %s", plan.Registry.ValueString()),
-		)
-		return
-	}
 
 	data := render.RegistryCredential{
 		Name:      plan.Name.ValueString(),
@@ -144,6 +142,10 @@ func (r *RegistryCredential) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	registryCredential, err := r.client.GetRegistryCredential(state.ID.ValueString())
+	if api.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Could not get Render registry credential: "+state.ID.ValueString(),
@@ -172,13 +174,6 @@ func (r *RegistryCredential) Update(ctx context.Context, req resource.UpdateRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	if plan.Registry.ValueString() != "GITHUB" && plan.Registry.ValueString() != "GITLAB" && plan.Registry.ValueString() != "DOCKER" {
-		resp.Diagnostics.AddError(
-			"Invalid registry",
-			fmt.Sprintf("The registry value must be one of GITHUB, GITLAB, or DOCKER, got: %s", plan.Registry.ValueString()),
-		)
-		return
-	}
 
 	data := render.RegistryCredential{
 		Name:      plan.Name.ValueString(),
@@ -217,7 +212,7 @@ func (r *RegistryCredential) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 
 	err := r.client.DeleteRegistryCredential(state.ID.ValueString())
-	if err != nil {
+	if err != nil && !api.IsNotFound(err) {
 		resp.Diagnostics.AddError(
 			"Error deleting Render registry credential",
 			"Could not delete registry credential ID: "+state.ID.ValueString()+": "+err.Error(),
@@ -1,19 +1,30 @@
 package provider
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/sonlir/render-client-go"
 )
 
-func TestAccRegistryCredentialDataSource(t *testing.T) {
-	resource.Test(t, resource.TestCase{
+func TestRegistryCredentialDataSource(t *testing.T) {
+	server, providerConfig := newTestServer(t)
+	ownerID := testOwnerID
+	id := server.AddRegistryCredential(render.RegistryCredential{
+		Name:     "asd",
+		Registry: "DOCKER",
+		Username: "ss",
+		OwnerId:  &ownerID,
+	})
+
+	resource.UnitTest(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: providerConfig + testAccRegistryCredentialDataSourceConfig,
+				Config: providerConfig + testRegistryCredentialDataSourceConfig(id),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("data.render_registrycredential.test", "id", "rgc-cnim7h7sc6pc73d1op80"),
+					resource.TestCheckResourceAttr("data.render_registrycredential.test", "id", id),
 					resource.TestCheckResourceAttr("data.render_registrycredential.test", "name", "asd"),
 					resource.TestCheckResourceAttr("data.render_registrycredential.test", "registry", "DOCKER"),
 					resource.TestCheckResourceAttr("data.render_registrycredential.test", "username", "ss"),
@@ -23,8 +34,48 @@ func TestAccRegistryCredentialDataSource(t *testing.T) {
 	})
 }
 
-const testAccRegistryCredentialDataSourceConfig = `
+func testRegistryCredentialDataSourceConfig(id string) string {
+	return fmt.Sprintf(`
 data "render_registrycredential" "test" {
-  id = "rgc-cnim7h7sc6pc73d1op80"
+  id = %q
+}
+`, id)
+}
+
+func TestRegistryCredentialsDataSource(t *testing.T) {
+	server, providerConfig := newTestServer(t)
+	ownerID := testOwnerID
+	id := server.AddRegistryCredential(render.RegistryCredential{
+		Name:     "docker",
+		Registry: "DOCKER",
+		Username: "jane",
+		OwnerId:  &ownerID,
+	})
+	server.AddRegistryCredential(render.RegistryCredential{
+		Name:     "github",
+		Registry: "GITHUB",
+		Username: "jane",
+		OwnerId:  &ownerID,
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "render_registrycredentials" "all" {}
+
+data "render_registrycredentials" "docker" {
+  name = "docker"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.render_registrycredentials.all", "registrycredentials.#", "2"),
+					resource.TestCheckResourceAttr("data.render_registrycredentials.docker", "registrycredentials.#", "1"),
+					resource.TestCheckResourceAttr("data.render_registrycredentials.docker", "registrycredentials.0.id", id),
+					resource.TestCheckResourceAttr("data.render_registrycredentials.docker", "registrycredentials.0.registry", "DOCKER"),
+				),
+			},
+		},
+	})
 }
-`
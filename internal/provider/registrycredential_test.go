@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/sonlir/render-client-go"
+
+	"terraform-provider-render/internal/fakerender"
+)
+
+func TestRegistryCredentialResource(t *testing.T) {
+	server, providerConfig := newTestServer(t)
+
+	var id string
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckRegistryCredentialDestroy(server),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testRegistryCredentialConfig("docker", "DOCKER", "jane"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("render_registrycredential.test", "id", func(value string) error {
+						id = value
+						return nil
+					}),
+					resource.TestCheckResourceAttr("render_registrycredential.test", "name", "docker"),
+					resource.TestCheckResourceAttr("render_registrycredential.test", "registry", "DOCKER"),
+					resource.TestCheckResourceAttr("render_registrycredential.test", "username", "jane"),
+					resource.TestCheckResourceAttr("render_registrycredential.test", "owner_id", testOwnerID),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "render_registrycredential.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The API doesn't return the auth token or the owner.
+				ImportStateVerifyIgnore: []string{"auth_token", "owner_id"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testRegistryCredentialConfig("github", "GITHUB", "jane"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("render_registrycredential.test", "id", &id),
+					resource.TestCheckResourceAttr("render_registrycredential.test", "name", "github"),
+					resource.TestCheckResourceAttr("render_registrycredential.test", "registry", "GITHUB"),
+					testCheckRegistryCredential(server, "render_registrycredential.test", func(registryCredential render.RegistryCredential) error {
+						if registryCredential.Name != "github" || registryCredential.Registry != "GITHUB" {
+							return fmt.Errorf("registry credential was not updated: %+v", registryCredential)
+						}
+						return nil
+					}),
+				),
+			},
+			// Changes made outside of Terraform are detected and reverted
+			{
+				PreConfig: func() {
+					server.UpdateRegistryCredential(id, func(registryCredential *render.RegistryCredential) {
+						registryCredential.Username = "john"
+					})
+				},
+				Config:             providerConfig + testRegistryCredentialConfig("github", "GITHUB", "jane"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: providerConfig + testRegistryCredentialConfig("github", "GITHUB", "jane"),
+				Check: testCheckRegistryCredential(server, "render_registrycredential.test", func(registryCredential render.RegistryCredential) error {
+					if registryCredential.Username != "jane" {
+						return fmt.Errorf("expected username jane, got %s", registryCredential.Username)
+					}
+					return nil
+				}),
+			},
+			// A registry credential deleted outside of Terraform is created again
+			{
+				PreConfig: func() {
+					server.DeleteRegistryCredential(id)
+				},
+				Config: providerConfig + testRegistryCredentialConfig("github", "GITHUB", "jane"),
+				Check: resource.TestCheckResourceAttrWith("render_registrycredential.test", "id", func(value string) error {
+					if value == id {
+						return fmt.Errorf("expected a new registry credential, got %s", value)
+					}
+					return nil
+				}),
+			},
+		},
+	})
+}
+
+func testRegistryCredentialConfig(name, registry, username string) string {
+	return fmt.Sprintf(`
+resource "render_registrycredential" "test" {
+  name       = %q
+  registry   = %q
+  username   = %q
+  auth_token = "secret"
+  owner_id   = %q
+}
+`, name, registry, username, testOwnerID)
+}
+
+func testCheckRegistryCredential(server *fakerender.Server, name string, check func(render.RegistryCredential) error) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", name)
+		}
+		registryCredential, ok := server.RegistryCredential(rs.Primary.ID)
+		if !ok {
+			return fmt.Errorf("registry credential %s does not exist", rs.Primary.ID)
+		}
+		return check(registryCredential)
+	}
+}
+
+func testCheckRegistryCredentialDestroy(server *fakerender.Server) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "render_registrycredential" {
+				continue
+			}
+			if _, ok := server.RegistryCredential(rs.Primary.ID); ok {
+				return fmt.Errorf("registry credential %s still exists", rs.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
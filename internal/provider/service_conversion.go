@@ -0,0 +1,593 @@
+package provider
+
+import (
+	"maps"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sonlir/render-client-go"
+
+	"terraform-provider-render/internal/api"
+)
+
+// The blocks below are shared by the web service, private service, background
+// worker and cron job resources and data sources. They are converted to and
+// from render.Service here, so that every service type maps them the same way.
+
+// ServiceResourceModel is the model of the web service, private service,
+// background worker and cron job resources, which only differ in their
+// service details.
+type ServiceResourceModel[D any] struct {
+	AutoDeploy     types.String                   `tfsdk:"auto_deploy"`
+	Branch         types.String                   `tfsdk:"branch"`
+	BuildFilter    *BuildFilter                   `tfsdk:"build_filter"`
+	EnvVars        map[string]EnvironmentVariable `tfsdk:"environment_variables"`
+	ID             types.String                   `tfsdk:"id"`
+	Image          *Image                         `tfsdk:"image"`
+	Name           types.String                   `tfsdk:"name"`
+	OwnerID        types.String                   `tfsdk:"owner_id"`
+	Repo           types.String                   `tfsdk:"repo"`
+	RootDir        types.String                   `tfsdk:"root_dir"`
+	SecretFiles    types.List                     `tfsdk:"secret_files"`
+	ServiceDetails *D                             `tfsdk:"service_details"`
+	Type           types.String                   `tfsdk:"type"`
+	CreateAt       types.String                   `tfsdk:"created_at"`
+	ImagePath      types.String                   `tfsdk:"image_path"`
+	NotifyOnFail   types.String                   `tfsdk:"notify_on_fail"`
+	Slug           types.String                   `tfsdk:"slug"`
+	Suspended      types.String                   `tfsdk:"suspended"`
+	Suspenders     types.List                     `tfsdk:"suspenders"`
+	UpdatedAt      types.String                   `tfsdk:"updated_at"`
+	WaitForDeploy  types.Bool                     `tfsdk:"wait_for_deploy"`
+	Timeouts       timeouts.Value                 `tfsdk:"timeouts"`
+}
+
+// serviceConversion converts a service resource of one type. The type only
+// provides the conversion of its service details.
+type serviceConversion[D any] struct {
+	// serviceType is the type of the service in the Render API.
+	serviceType string
+	// makeDetailsModel is given an empty current when the service is being
+	// imported.
+	makeDetailsModel func(current *D, imported bool, serviceDetails render.ServiceDetails) *D
+	makeDetailsData  func(details *D) render.ServiceDetails
+}
+
+func (c serviceConversion[D]) makeModel(state *ServiceResourceModel[D], service *render.Service) {
+	// Nothing but the ID is known about a service that is being imported.
+	imported := state.ServiceDetails == nil
+	current := state.ServiceDetails
+	if imported {
+		current = new(D)
+	}
+	state.AutoDeploy = types.StringValue(service.AutoDeploy)
+	state.Branch = types.StringValue(service.Branch)
+	state.BuildFilter = makeBuildFilterModel(state.BuildFilter, imported, service.BuildFilter)
+	// The image is only read on import, afterwards the configured image is
+	// kept as Render may return the path in a different form.
+	if imported && service.ServiceDetails.Env == "image" {
+		state.Image = makeImageModel(service)
+	}
+	state.CreateAt = types.StringValue(service.CreateAt)
+	state.ImagePath = types.StringValue(service.ImagePath)
+	state.ID = types.StringValue(service.ID)
+	state.Name = types.StringValue(service.Name)
+	state.NotifyOnFail = types.StringValue(service.NotifyOnFail)
+	state.OwnerID = types.StringValue(service.OwnerID)
+	state.Repo = types.StringValue(service.Repo)
+	state.RootDir = types.StringValue(service.RootDir)
+	state.Slug = types.StringValue(service.Slug)
+	state.Suspended = types.StringValue(service.Suspended)
+	state.Suspenders = stringListValue(service.Suspenders)
+	state.Type = types.StringValue(service.Type)
+	state.UpdatedAt = types.StringValue(service.UpdatedAt)
+	state.EnvVars = makeServiceEnvVarsModel(state.EnvVars, imported, service.EnvVars)
+	state.SecretFiles = makeSecretFilesModel(state.SecretFiles, service.SecretFiles)
+	state.ServiceDetails = c.makeDetailsModel(current, imported, service.ServiceDetails)
+}
+
+func (c serviceConversion[D]) makeData(plan *ServiceResourceModel[D]) *api.ServiceData {
+	return &api.ServiceData{
+		Service: render.Service{
+			AutoDeploy:     plan.AutoDeploy.ValueString(),
+			Branch:         plan.Branch.ValueString(),
+			BuildFilter:    makeBuildFilterData(plan.BuildFilter),
+			Image:          makeImageData(plan.Image),
+			Name:           plan.Name.ValueString(),
+			OwnerID:        plan.OwnerID.ValueString(),
+			Repo:           plan.Repo.ValueString(),
+			RootDir:        plan.RootDir.ValueString(),
+			SecretFiles:    makeSecretFilesData(plan.SecretFiles),
+			ServiceDetails: c.makeDetailsData(plan.ServiceDetails),
+			Type:           c.serviceType,
+		},
+		EnvVars: makeEnvVarsData(plan.EnvVars),
+	}
+}
+
+// makeServiceDataSourceModel sets the attributes that all service data sources
+// have in common. The service details are set by the caller.
+func makeServiceDataSourceModel(state *ServiceDataSourceModel, service *render.Service) {
+	state.AutoDeploy = types.StringValue(service.AutoDeploy)
+	state.Branch = types.StringValue(service.Branch)
+	state.BuildFilter = makeBuildFilterModel(nil, true, service.BuildFilter)
+	state.CreateAt = types.StringValue(service.CreateAt)
+	state.EnvVars = makeEnvVarsDataSourceModel(service.EnvVars)
+	state.ID = types.StringValue(service.ID)
+	state.ImagePath = types.StringValue(service.ImagePath)
+	state.Name = types.StringValue(service.Name)
+	state.NotifyOnFail = types.StringValue(service.NotifyOnFail)
+	state.OwnerID = types.StringValue(service.OwnerID)
+	state.Repo = types.StringValue(service.Repo)
+	state.RootDir = types.StringValue(service.RootDir)
+	state.Slug = types.StringValue(service.Slug)
+	state.Suspended = types.StringValue(service.Suspended)
+	state.Suspenders = []types.String{}
+	for _, suspender := range service.Suspenders {
+		state.Suspenders = append(state.Suspenders, types.StringValue(suspender))
+	}
+	state.Type = types.StringValue(service.Type)
+	state.UpdatedAt = types.StringValue(service.UpdatedAt)
+}
+
+// makeBuildFilterModel returns the build filter of a service. It is only kept
+// when it is configured, or when an imported service has one.
+func makeBuildFilterModel(current *BuildFilter, imported bool, buildFilter *render.BuildFilter) *BuildFilter {
+	if buildFilter == nil {
+		return current
+	}
+	if current == nil && (!imported || len(buildFilter.Paths) == 0 && len(buildFilter.IgnoredPaths) == 0) {
+		return nil
+	}
+	result := &BuildFilter{Paths: []types.String{}, IgnoredPaths: []types.String{}}
+	for _, path := range buildFilter.Paths {
+		result.Paths = append(result.Paths, types.StringValue(path))
+	}
+	for _, ignoredPath := range buildFilter.IgnoredPaths {
+		result.IgnoredPaths = append(result.IgnoredPaths, types.StringValue(ignoredPath))
+	}
+	return result
+}
+
+// makeBuildFilterData always returns a build filter, so that removing the
+// block clears the filter on the service.
+func makeBuildFilterData(buildFilter *BuildFilter) *render.BuildFilter {
+	result := &render.BuildFilter{Paths: []string{}, IgnoredPaths: []string{}}
+	if buildFilter != nil {
+		for _, path := range buildFilter.Paths {
+			result.Paths = append(result.Paths, path.ValueString())
+		}
+		for _, ignoredPath := range buildFilter.IgnoredPaths {
+			result.IgnoredPaths = append(result.IgnoredPaths, ignoredPath.ValueString())
+		}
+	}
+	return result
+}
+
+// makeImageModel returns the image of an image backed service. When Render
+// leaves out `image`, it is made from the image path and registry credential
+// of the service.
+func makeImageModel(service *render.Service) *Image {
+	if service.Image != nil {
+		image := &Image{
+			OwnerID:              types.StringValue(service.Image.OwnerId),
+			ImagePath:            types.StringValue(service.Image.ImagePath),
+			RegistryCredentialId: types.StringNull(),
+		}
+		if service.Image.RegistryCredentialId != "" {
+			image.RegistryCredentialId = types.StringValue(service.Image.RegistryCredentialId)
+		}
+		return image
+	}
+
+	image := &Image{
+		OwnerID:              types.StringValue(service.OwnerID),
+		ImagePath:            types.StringValue(service.ImagePath),
+		RegistryCredentialId: types.StringNull(),
+	}
+	if service.ServiceDetails.EnvSpecificDetails != nil && service.ServiceDetails.EnvSpecificDetails.RegistryCredential != nil {
+		image.RegistryCredentialId = types.StringValue(service.ServiceDetails.EnvSpecificDetails.RegistryCredential.ID)
+	}
+	return image
+}
+
+func makeImageData(image *Image) *render.Image {
+	if image == nil {
+		return nil
+	}
+	return &render.Image{
+		OwnerId:              image.OwnerID.ValueString(),
+		RegistryCredentialId: image.RegistryCredentialId.ValueString(),
+		ImagePath:            image.ImagePath.ValueString(),
+	}
+}
+
+// makeEnvSpecificDetailsModel returns the block matching the runtime of the
+// service. The other one, and both for image backed services, is nil.
+func makeEnvSpecificDetailsModel(serviceDetails render.ServiceDetails) (*DockerDetails, *NativeEnvironmentDetails) {
+	details := serviceDetails.EnvSpecificDetails
+	if details == nil {
+		return nil, nil
+	}
+	switch serviceDetails.Env {
+	case "docker":
+		dockerDetails := &DockerDetails{
+			DockerCommand:        types.StringPointerValue(details.DockerCommand),
+			DockerContext:        types.StringPointerValue(details.DockerContext),
+			DockerfilePath:       types.StringPointerValue(details.DockerfilePath),
+			PreDeployCommand:     types.StringPointerValue(details.PreDeployCommand),
+			RegistryCredentialId: types.StringNull(),
+		}
+		if details.RegistryCredential != nil {
+			dockerDetails.RegistryCredentialId = types.StringValue(details.RegistryCredential.ID)
+		}
+		return dockerDetails, nil
+	case "image":
+		return nil, nil
+	default:
+		return nil, &NativeEnvironmentDetails{
+			PreDeployCommand: types.StringPointerValue(details.PreDeployCommand),
+			BuildCommand:     types.StringPointerValue(details.BuildCommand),
+			StartCommand:     types.StringPointerValue(details.StartCommand),
+		}
+	}
+}
+
+// makeEnvSpecificDetailsResourceModel returns the block matching the runtime
+// of the service for a resource. It is only filled when it is configured or
+// the service is being imported, so that a block left out of the
+// configuration stays null.
+func makeEnvSpecificDetailsResourceModel(currentDockerDetails *DockerDetails, currentNativeEnvironmentDetails *NativeEnvironmentDetails, imported bool, serviceDetails render.ServiceDetails) (*DockerDetails, *NativeEnvironmentDetails) {
+	dockerDetails, nativeEnvironmentDetails := makeEnvSpecificDetailsModel(serviceDetails)
+	if !imported && currentDockerDetails == nil {
+		dockerDetails = nil
+	}
+	if !imported && currentNativeEnvironmentDetails == nil {
+		nativeEnvironmentDetails = nil
+	}
+	return dockerDetails, nativeEnvironmentDetails
+}
+
+// makeEnvSpecificDetailsData returns the details of the block matching env.
+// Unknown attributes are left out, so that Render fills them in.
+func makeEnvSpecificDetailsData(env types.String, dockerDetails *DockerDetails, nativeEnvironmentDetails *NativeEnvironmentDetails) *render.EnvSpecificDetails {
+	switch env.ValueString() {
+	case "docker":
+		if dockerDetails == nil {
+			return nil
+		}
+		return &render.EnvSpecificDetails{
+			DockerCommand:        knownStringPointer(dockerDetails.DockerCommand),
+			DockerContext:        knownStringPointer(dockerDetails.DockerContext),
+			DockerfilePath:       knownStringPointer(dockerDetails.DockerfilePath),
+			PreDeployCommand:     knownStringPointer(dockerDetails.PreDeployCommand),
+			RegistryCredentialId: knownStringPointer(dockerDetails.RegistryCredentialId),
+		}
+	case "image":
+		return nil
+	default:
+		if nativeEnvironmentDetails == nil {
+			return nil
+		}
+		return &render.EnvSpecificDetails{
+			PreDeployCommand: knownStringPointer(nativeEnvironmentDetails.PreDeployCommand),
+			BuildCommand:     knownStringPointer(nativeEnvironmentDetails.BuildCommand),
+			StartCommand:     knownStringPointer(nativeEnvironmentDetails.StartCommand),
+		}
+	}
+}
+
+// makeServiceDiskModel returns the disk of a service. It is only tracked when
+// it is configured on the service or the service is being imported, so that
+// it can be managed with render_disk instead.
+func makeServiceDiskModel(current *Disk, imported bool, disk *render.Disk) *Disk {
+	if disk == nil || current == nil && !imported {
+		return nil
+	}
+	return &Disk{
+		ID:        types.StringValue(disk.Id),
+		Name:      types.StringValue(disk.Name),
+		MountPath: types.StringValue(disk.MountPath),
+		SizeGB:    types.Int64Value(disk.SizeGB),
+	}
+}
+
+func makeServiceDiskData(disk *Disk) *render.Disk {
+	if disk == nil {
+		return nil
+	}
+	return &render.Disk{
+		Name:      disk.Name.ValueString(),
+		MountPath: disk.MountPath.ValueString(),
+		SizeGB:    disk.SizeGB.ValueInt64(),
+	}
+}
+
+func makeAutoscalingModel(autoscaling *render.Autoscaling) *Autoscaling {
+	if autoscaling == nil {
+		return nil
+	}
+	var criteria render.AutoscalingCriteria
+	if autoscaling.Criteria != nil {
+		criteria = *autoscaling.Criteria
+	}
+	return &Autoscaling{
+		Enabled: types.BoolValue(autoscaling.Enabled),
+		Min:     types.Int64Value(autoscaling.Min),
+		Max:     types.Int64Value(autoscaling.Max),
+		Criteria: AutoscalingCriteria{
+			CPU:    makeAutoscalingCriteriaObjectModel(criteria.CPU),
+			Memory: makeAutoscalingCriteriaObjectModel(criteria.Memory),
+		},
+	}
+}
+
+func makeAutoscalingCriteriaObjectModel(criteria *render.AutoscalingCriteriaObject) AutoscalingCriteriaObject {
+	if criteria == nil {
+		return AutoscalingCriteriaObject{Enabled: types.BoolValue(false), Percentage: types.Int64Value(0)}
+	}
+	return AutoscalingCriteriaObject{
+		Enabled:    types.BoolValue(criteria.Enabled),
+		Percentage: types.Int64Value(criteria.Percentage),
+	}
+}
+
+func makeAutoscalingData(autoscaling *Autoscaling) *render.Autoscaling {
+	if autoscaling == nil {
+		return nil
+	}
+	return &render.Autoscaling{
+		Enabled: autoscaling.Enabled.ValueBool(),
+		Min:     autoscaling.Min.ValueInt64(),
+		Max:     autoscaling.Max.ValueInt64(),
+		Criteria: &render.AutoscalingCriteria{
+			CPU: &render.AutoscalingCriteriaObject{
+				Enabled:    autoscaling.Criteria.CPU.Enabled.ValueBool(),
+				Percentage: autoscaling.Criteria.CPU.Percentage.ValueInt64(),
+			},
+			Memory: &render.AutoscalingCriteriaObject{
+				Enabled:    autoscaling.Criteria.Memory.Enabled.ValueBool(),
+				Percentage: autoscaling.Criteria.Memory.Percentage.ValueInt64(),
+			},
+		},
+	}
+}
+
+func makeParentServerModel(parentServer *render.ParentServer) *ParentServer {
+	if parentServer == nil {
+		return nil
+	}
+	return &ParentServer{
+		ID:   types.StringValue(parentServer.ID),
+		Name: types.StringValue(parentServer.Name),
+	}
+}
+
+func makeOpenPortsModel(openPorts []render.OpenPort) []OpenPort {
+	result := []OpenPort{}
+	for _, openPort := range openPorts {
+		result = append(result, OpenPort{
+			Port:     types.Int64Value(openPort.Port),
+			Protocol: types.StringValue(openPort.Protocol),
+		})
+	}
+	return result
+}
+
+// makeEnvVarsModel returns the environment variables keyed by name, so that
+// the order Render lists them in doesn't matter. Render doesn't know how the
+// values were set, so generate_value and ignore_value are kept from current.
+func makeEnvVarsModel(current map[string]EnvironmentVariable, envVars []render.EnvironmentVariable) map[string]EnvironmentVariable {
+	result := map[string]EnvironmentVariable{}
+	for _, envVar := range envVars {
+		result[envVar.Key] = EnvironmentVariable{
+			Value:         types.StringValue(envVar.Value),
+			GenerateValue: types.BoolValue(current[envVar.Key].GenerateValue.ValueBool()),
+			IgnoreValue:   types.BoolValue(current[envVar.Key].IgnoreValue.ValueBool()),
+		}
+	}
+	return result
+}
+
+// makeServiceEnvVarsModel is like makeEnvVarsModel for the resources of
+// services, whose environment_variables are only tracked when they are
+// configured, or when the service is imported with some variables.
+func makeServiceEnvVarsModel(current map[string]EnvironmentVariable, imported bool, envVars []render.EnvironmentVariable) map[string]EnvironmentVariable {
+	if current == nil && (!imported || len(envVars) == 0) {
+		return nil
+	}
+	return makeEnvVarsModel(current, envVars)
+}
+
+func makeEnvVarsDataSourceModel(envVars []render.EnvironmentVariable) map[string]EnvironmentVariableDataSourceModel {
+	result := map[string]EnvironmentVariableDataSourceModel{}
+	for _, envVar := range envVars {
+		result[envVar.Key] = EnvironmentVariableDataSourceModel{Value: types.StringValue(envVar.Value)}
+	}
+	return result
+}
+
+// makeEnvVarsData returns the environment variables sorted by name, so that
+// requests don't depend on the order of the map, or nil if they are not
+// configured so that they are left alone. Render generates the values that
+// are still unknown when generate_value is set, existing generated values are
+// sent as they are.
+func makeEnvVarsData(envVars map[string]EnvironmentVariable) []api.EnvVarInput {
+	if envVars == nil {
+		return nil
+	}
+
+	keys := make([]string, 0, len(envVars))
+	for key := range envVars {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	result := []api.EnvVarInput{}
+	for _, key := range keys {
+		result = append(result, api.EnvVarInput{
+			Key:         key,
+			EnvVarValue: makeEnvVarValueData(envVars[key].Value, envVars[key].GenerateValue),
+		})
+	}
+	return result
+}
+
+func makeEnvVarValueData(value types.String, generateValue types.Bool) api.EnvVarValue {
+	if generateValue.ValueBool() && (value.IsUnknown() || value.IsNull()) {
+		return api.EnvVarValue{GenerateValue: true}
+	}
+	return api.EnvVarValue{Value: value.ValueStringPointer()}
+}
+
+// envVarsChanged reports whether plan changes the value of any environment
+// variable in state. Services only replace their environment variables when
+// they change, so that the ones managed with render_service_env_var are kept.
+func envVarsChanged(plan, state map[string]EnvironmentVariable) bool {
+	return !maps.EqualFunc(plan, state, func(planned, current EnvironmentVariable) bool {
+		return planned.Value.Equal(current.Value)
+	})
+}
+
+// makeSecretFilesModel keeps the secret files in the order of current, so that
+// reading them back doesn't reorder the list. Files that are not in current
+// follow in the order Render returns them.
+func makeSecretFilesModel(current types.List, secretFiles []render.SecretFiles) types.List {
+	result := []render.SecretFiles{}
+	added := map[string]bool{}
+	for _, currentFile := range secretFilesElements(current) {
+		for _, secretFile := range secretFiles {
+			if secretFile.Name == currentFile.Name.ValueString() && !added[secretFile.Name] {
+				result = append(result, secretFile)
+				added[secretFile.Name] = true
+			}
+		}
+	}
+	for _, secretFile := range secretFiles {
+		if !added[secretFile.Name] {
+			result = append(result, secretFile)
+			added[secretFile.Name] = true
+		}
+	}
+	return secretFilesValue(result)
+}
+
+// makeSecretFilesData returns nil if the secret files are unknown, which
+// happens when they are not configured, so that they are left alone.
+func makeSecretFilesData(secretFiles types.List) []render.SecretFiles {
+	if secretFiles.IsUnknown() || secretFiles.IsNull() {
+		return nil
+	}
+	result := []render.SecretFiles{}
+	for _, secretFile := range secretFilesElements(secretFiles) {
+		result = append(result, render.SecretFiles{
+			Name:     secretFile.Name.ValueString(),
+			Contents: secretFile.Contents.ValueString(),
+		})
+	}
+	return result
+}
+
+// secretFilesElements returns the secret files in a list of secretFileType
+// objects, or none if the list is unknown.
+func secretFilesElements(secretFiles types.List) []SecretFiles {
+	result := []SecretFiles{}
+	for _, element := range secretFiles.Elements() {
+		object, ok := element.(types.Object)
+		if !ok {
+			continue
+		}
+		attributes := object.Attributes()
+		name, _ := attributes["name"].(types.String)
+		contents, _ := attributes["content"].(types.String)
+		result = append(result, SecretFiles{Name: name, Contents: contents})
+	}
+	return result
+}
+
+func secretFilesValue(secretFiles []render.SecretFiles) types.List {
+	elements := []attr.Value{}
+	for _, secretFile := range secretFiles {
+		elements = append(elements, types.ObjectValueMust(secretFileType.AttrTypes, map[string]attr.Value{
+			"name":    types.StringValue(secretFile.Name),
+			"content": types.StringValue(secretFile.Contents),
+		}))
+	}
+	return types.ListValueMust(secretFileType, elements)
+}
+
+func routesValue(routes []render.Route) types.List {
+	elements := []attr.Value{}
+	for _, route := range routes {
+		elements = append(elements, types.ObjectValueMust(routeType.AttrTypes, map[string]attr.Value{
+			"type":        types.StringValue(route.Type),
+			"source":      types.StringValue(route.Source),
+			"destination": types.StringValue(route.Destination),
+		}))
+	}
+	return types.ListValueMust(routeType, elements)
+}
+
+// makeRoutesData returns nil if the routes are unknown, which happens when
+// they are not configured, so that they are left alone.
+func makeRoutesData(routes types.List) []render.Route {
+	if routes.IsUnknown() || routes.IsNull() {
+		return nil
+	}
+	result := []render.Route{}
+	for _, element := range routes.Elements() {
+		object, ok := element.(types.Object)
+		if !ok {
+			continue
+		}
+		attributes := object.Attributes()
+		routeType, _ := attributes["type"].(types.String)
+		source, _ := attributes["source"].(types.String)
+		destination, _ := attributes["destination"].(types.String)
+		result = append(result, render.Route{
+			Type:        routeType.ValueString(),
+			Source:      source.ValueString(),
+			Destination: destination.ValueString(),
+		})
+	}
+	return result
+}
+
+func headersValue(headers []render.Header) types.List {
+	elements := []attr.Value{}
+	for _, header := range headers {
+		elements = append(elements, types.ObjectValueMust(headerType.AttrTypes, map[string]attr.Value{
+			"path":  types.StringValue(header.Path),
+			"name":  types.StringValue(header.Name),
+			"value": types.StringValue(header.Value),
+		}))
+	}
+	return types.ListValueMust(headerType, elements)
+}
+
+// makeHeadersData returns nil if the headers are unknown, which happens when
+// they are not configured, so that they are left alone.
+func makeHeadersData(headers types.List) []render.Header {
+	if headers.IsUnknown() || headers.IsNull() {
+		return nil
+	}
+	result := []render.Header{}
+	for _, element := range headers.Elements() {
+		object, ok := element.(types.Object)
+		if !ok {
+			continue
+		}
+		attributes := object.Attributes()
+		path, _ := attributes["path"].(types.String)
+		name, _ := attributes["name"].(types.String)
+		value, _ := attributes["value"].(types.String)
+		result = append(result, render.Header{
+			Path:  path.ValueString(),
+			Name:  name.ValueString(),
+			Value: value.ValueString(),
+		})
+	}
+	return result
+}
@@ -0,0 +1,825 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/sonlir/render-client-go"
+
+	"terraform-provider-render/internal/api"
+)
+
+const (
+	testServiceID            = "srv-test"
+	testRegistryCredentialID = "rgc-test"
+	testImagePath            = "docker.io/library/nginx:latest"
+)
+
+var testOpenPorts = []render.OpenPort{{Port: 10000, Protocol: "TCP"}}
+
+// testRuntime returns the image, docker details and native environment
+// details that are configured for a service using env.
+func testRuntime(env string) (*Image, *DockerDetails, *NativeEnvironmentDetails) {
+	switch env {
+	case "docker":
+		return nil, &DockerDetails{
+			DockerCommand:        types.StringValue("./start.sh"),
+			DockerContext:        types.StringValue("."),
+			DockerfilePath:       types.StringValue("./Dockerfile"),
+			PreDeployCommand:     types.StringValue("./migrate.sh"),
+			RegistryCredentialId: types.StringValue(testRegistryCredentialID),
+		}, nil
+	case "image":
+		return &Image{
+			OwnerID:              types.StringValue(testOwnerID),
+			RegistryCredentialId: types.StringValue(testRegistryCredentialID),
+			ImagePath:            types.StringValue(testImagePath),
+		}, nil, nil
+	default:
+		return nil, nil, &NativeEnvironmentDetails{
+			BuildCommand:     types.StringValue("make build"),
+			StartCommand:     types.StringValue("make start"),
+			PreDeployCommand: types.StringValue("make migrate"),
+		}
+	}
+}
+
+func testImagePathValue(env string) types.String {
+	if env == "image" {
+		return types.StringValue(testImagePath)
+	}
+	return types.StringValue("")
+}
+
+func testBuildFilter() *BuildFilter {
+	return &BuildFilter{
+		Paths:        []types.String{types.StringValue("src/**")},
+		IgnoredPaths: []types.String{types.StringValue("docs/**")},
+	}
+}
+
+func testEnvVars() map[string]EnvironmentVariable {
+	return map[string]EnvironmentVariable{
+		"NODE_ENV": testEnvVar("production"),
+		"PORT":     testEnvVar("10000"),
+		"EMPTY":    testEnvVar(""),
+	}
+}
+
+func testEnvVar(value string) EnvironmentVariable {
+	return EnvironmentVariable{
+		Value:         types.StringValue(value),
+		GenerateValue: types.BoolValue(false),
+		IgnoreValue:   types.BoolValue(false),
+	}
+}
+
+// testDataSourceEnvVars returns the environment variables the data sources
+// read for envVars.
+func testDataSourceEnvVars(envVars map[string]EnvironmentVariable) map[string]EnvironmentVariableDataSourceModel {
+	result := map[string]EnvironmentVariableDataSourceModel{}
+	for key, envVar := range envVars {
+		result[key] = EnvironmentVariableDataSourceModel{Value: envVar.Value}
+	}
+	return result
+}
+
+func testSecretFiles() types.List {
+	return secretFilesValue([]render.SecretFiles{
+		{Name: "secret.txt", Contents: "s3cr3t"},
+		{Name: ".env", Contents: "A=1"},
+	})
+}
+
+func testDisk() *Disk {
+	return &Disk{
+		ID:        types.StringValue("dsk-test"),
+		Name:      types.StringValue("data"),
+		MountPath: types.StringValue("/var/data"),
+		SizeGB:    types.Int64Value(10),
+	}
+}
+
+func testAutoscaling() *Autoscaling {
+	return &Autoscaling{
+		Enabled: types.BoolValue(true),
+		Min:     types.Int64Value(1),
+		Max:     types.Int64Value(3),
+		Criteria: AutoscalingCriteria{
+			CPU:    AutoscalingCriteriaObject{Enabled: types.BoolValue(true), Percentage: types.Int64Value(60)},
+			Memory: AutoscalingCriteriaObject{Enabled: types.BoolValue(false), Percentage: types.Int64Value(0)},
+		},
+	}
+}
+
+// testRenderResponse returns what Render responds with for a service created
+// from data: the attributes Render computes are filled in, the registry
+// credential is expanded, environment variables are listed newest first and
+// generated values are filled in.
+func testRenderResponse(data *api.ServiceData) *render.Service {
+	service := data.Service
+	service.ID = testServiceID
+	service.CreateAt = "2024-03-01T12:00:00Z"
+	service.UpdatedAt = "2024-03-02T12:00:00Z"
+	service.NotifyOnFail = "default"
+	service.Slug = "my-service"
+	service.Suspended = "not_suspended"
+	service.EnvVars = nil
+	for i := len(data.EnvVars) - 1; i >= 0; i-- {
+		envVar := render.EnvironmentVariable{Key: data.EnvVars[i].Key, Value: "generated"}
+		if data.EnvVars[i].Value != nil {
+			envVar.Value = *data.EnvVars[i].Value
+		}
+		service.EnvVars = append(service.EnvVars, envVar)
+	}
+	if data.Image != nil {
+		service.ImagePath = data.Image.ImagePath
+	}
+
+	details := &service.ServiceDetails
+	if data.ServiceDetails.EnvSpecificDetails != nil {
+		envSpecificDetails := *data.ServiceDetails.EnvSpecificDetails
+		if envSpecificDetails.RegistryCredentialId != nil {
+			envSpecificDetails.RegistryCredential = &render.RegistryCredential{ID: *envSpecificDetails.RegistryCredentialId}
+			envSpecificDetails.RegistryCredentialId = nil
+		}
+		details.EnvSpecificDetails = &envSpecificDetails
+	}
+	if data.ServiceDetails.Disk != nil {
+		disk := *data.ServiceDetails.Disk
+		disk.Id = "dsk-test"
+		details.Disk = &disk
+	}
+	switch data.Type {
+	case "web_service", "private_service":
+		details.URL = "https://my-service.onrender.com"
+		details.OpenPorts = testOpenPorts
+		details.ParentServer = &render.ParentServer{ID: "srv-parent", Name: "parent"}
+	case "background_worker":
+		details.ParentServer = &render.ParentServer{ID: "srv-parent", Name: "parent"}
+	case "cron_job":
+		details.LastSuccessfulRunAt = "2024-03-03T12:00:00Z"
+	}
+	return &service
+}
+
+func testWebServiceModel(env string) WebServiceModel {
+	image, dockerDetails, nativeEnvironmentDetails := testRuntime(env)
+	return WebServiceModel{
+		AutoDeploy:   types.StringValue("yes"),
+		Branch:       types.StringValue("main"),
+		BuildFilter:  testBuildFilter(),
+		EnvVars:      testEnvVars(),
+		ID:           types.StringValue(testServiceID),
+		Image:        image,
+		Name:         types.StringValue("my-service"),
+		OwnerID:      types.StringValue(testOwnerID),
+		Repo:         types.StringValue("https://github.com/render-examples/express-hello-world"),
+		RootDir:      types.StringValue("app"),
+		SecretFiles:  testSecretFiles(),
+		Type:         types.StringValue("web_service"),
+		CreateAt:     types.StringValue("2024-03-01T12:00:00Z"),
+		ImagePath:    testImagePathValue(env),
+		NotifyOnFail: types.StringValue("default"),
+		Slug:         types.StringValue("my-service"),
+		Suspended:    types.StringValue("not_suspended"),
+		Suspenders:   stringListValue(nil),
+		UpdatedAt:    types.StringValue("2024-03-02T12:00:00Z"),
+		ServiceDetails: &WebServiceDetails{
+			Autoscaling:                testAutoscaling(),
+			Disk:                       testDisk(),
+			Env:                        types.StringValue(env),
+			DockerDetails:              dockerDetails,
+			NativeEnvironmentDetails:   nativeEnvironmentDetails,
+			HealthCheckPath:            types.StringValue("/healthz"),
+			NumInstances:               types.Int64Value(2),
+			Plan:                       types.StringValue("standard"),
+			PullRequestPreviewsEnabled: types.StringValue("no"),
+			Region:                     types.StringValue("frankfurt"),
+			OpenPorts:                  openPortsValue(testOpenPorts),
+			ParentServer:               parentServerValue(&render.ParentServer{ID: "srv-parent", Name: "parent"}),
+			URL:                        types.StringValue("https://my-service.onrender.com"),
+		},
+		WaitForDeploy: types.BoolValue(false),
+		Timeouts:      testTimeouts(),
+	}
+}
+
+func testTimeouts() timeouts.Value {
+	return timeouts.Value{Object: types.ObjectNull(map[string]attr.Type{
+		"create": types.StringType,
+		"update": types.StringType,
+	})}
+}
+
+func testPrivateServiceModel(env string) PrivateServiceModel {
+	image, dockerDetails, nativeEnvironmentDetails := testRuntime(env)
+	return PrivateServiceModel{
+		AutoDeploy:   types.StringValue("yes"),
+		Branch:       types.StringValue("main"),
+		BuildFilter:  testBuildFilter(),
+		EnvVars:      testEnvVars(),
+		ID:           types.StringValue(testServiceID),
+		Image:        image,
+		Name:         types.StringValue("my-service"),
+		OwnerID:      types.StringValue(testOwnerID),
+		Repo:         types.StringValue("https://github.com/render-examples/express-hello-world"),
+		RootDir:      types.StringValue("app"),
+		SecretFiles:  testSecretFiles(),
+		Type:         types.StringValue("private_service"),
+		CreateAt:     types.StringValue("2024-03-01T12:00:00Z"),
+		ImagePath:    testImagePathValue(env),
+		NotifyOnFail: types.StringValue("default"),
+		Slug:         types.StringValue("my-service"),
+		Suspended:    types.StringValue("not_suspended"),
+		Suspenders:   stringListValue(nil),
+		UpdatedAt:    types.StringValue("2024-03-02T12:00:00Z"),
+		ServiceDetails: &PrivateServiceDetails{
+			Autoscaling:                testAutoscaling(),
+			Disk:                       testDisk(),
+			Env:                        types.StringValue(env),
+			DockerDetails:              dockerDetails,
+			NativeEnvironmentDetails:   nativeEnvironmentDetails,
+			NumInstances:               types.Int64Value(2),
+			Plan:                       types.StringValue("standard"),
+			PullRequestPreviewsEnabled: types.StringValue("no"),
+			Region:                     types.StringValue("frankfurt"),
+			OpenPorts:                  openPortsValue(testOpenPorts),
+			ParentServer:               parentServerValue(&render.ParentServer{ID: "srv-parent", Name: "parent"}),
+			URL:                        types.StringValue("https://my-service.onrender.com"),
+		},
+		WaitForDeploy: types.BoolValue(false),
+		Timeouts:      testTimeouts(),
+	}
+}
+
+func testBackgroundWorkerModel(env string) BackgroundWorkerModel {
+	image, dockerDetails, nativeEnvironmentDetails := testRuntime(env)
+	return BackgroundWorkerModel{
+		AutoDeploy:   types.StringValue("yes"),
+		Branch:       types.StringValue("main"),
+		BuildFilter:  testBuildFilter(),
+		EnvVars:      testEnvVars(),
+		ID:           types.StringValue(testServiceID),
+		Image:        image,
+		Name:         types.StringValue("my-service"),
+		OwnerID:      types.StringValue(testOwnerID),
+		Repo:         types.StringValue("https://github.com/render-examples/express-hello-world"),
+		RootDir:      types.StringValue("app"),
+		SecretFiles:  testSecretFiles(),
+		Type:         types.StringValue("background_worker"),
+		CreateAt:     types.StringValue("2024-03-01T12:00:00Z"),
+		ImagePath:    testImagePathValue(env),
+		NotifyOnFail: types.StringValue("default"),
+		Slug:         types.StringValue("my-service"),
+		Suspended:    types.StringValue("not_suspended"),
+		Suspenders:   stringListValue(nil),
+		UpdatedAt:    types.StringValue("2024-03-02T12:00:00Z"),
+		ServiceDetails: &BackgroundWorkerDetails{
+			Autoscaling:                testAutoscaling(),
+			Disk:                       testDisk(),
+			Env:                        types.StringValue(env),
+			DockerDetails:              dockerDetails,
+			NativeEnvironmentDetails:   nativeEnvironmentDetails,
+			NumInstances:               types.Int64Value(2),
+			Plan:                       types.StringValue("standard"),
+			PullRequestPreviewsEnabled: types.StringValue("no"),
+			Region:                     types.StringValue("frankfurt"),
+			ParentServer:               parentServerValue(&render.ParentServer{ID: "srv-parent", Name: "parent"}),
+		},
+		WaitForDeploy: types.BoolValue(false),
+		Timeouts:      testTimeouts(),
+	}
+}
+
+func testCronJobModel(env string) CronJobModel {
+	image, dockerDetails, nativeEnvironmentDetails := testRuntime(env)
+	return CronJobModel{
+		AutoDeploy:   types.StringValue("yes"),
+		Branch:       types.StringValue("main"),
+		BuildFilter:  testBuildFilter(),
+		EnvVars:      testEnvVars(),
+		ID:           types.StringValue(testServiceID),
+		Image:        image,
+		Name:         types.StringValue("my-service"),
+		OwnerID:      types.StringValue(testOwnerID),
+		Repo:         types.StringValue("https://github.com/render-examples/express-hello-world"),
+		RootDir:      types.StringValue("app"),
+		SecretFiles:  testSecretFiles(),
+		Type:         types.StringValue("cron_job"),
+		CreateAt:     types.StringValue("2024-03-01T12:00:00Z"),
+		ImagePath:    testImagePathValue(env),
+		NotifyOnFail: types.StringValue("default"),
+		Slug:         types.StringValue("my-service"),
+		Suspended:    types.StringValue("not_suspended"),
+		Suspenders:   stringListValue(nil),
+		UpdatedAt:    types.StringValue("2024-03-02T12:00:00Z"),
+		ServiceDetails: &CronJobDetails{
+			Env:                      types.StringValue(env),
+			DockerDetails:            dockerDetails,
+			NativeEnvironmentDetails: nativeEnvironmentDetails,
+			LastSuccessfulRunAt:      types.StringValue("2024-03-03T12:00:00Z"),
+			Plan:                     types.StringValue("standard"),
+			Region:                   types.StringValue("frankfurt"),
+			Schedule:                 types.StringValue("0 * * * *"),
+		},
+		WaitForDeploy: types.BoolValue(false),
+		Timeouts:      testTimeouts(),
+	}
+}
+
+// testStateValue converts model to a Terraform value using the schema of r,
+// which also makes sure that the model can be saved as state.
+func testStateValue(t *testing.T, r resource.Resource, model any) tftypes.Value {
+	t.Helper()
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	if diags := state.Set(ctx, model); diags.HasError() {
+		t.Fatalf("unable to set state: %v", diags)
+	}
+	return state.Raw
+}
+
+func testCheckModel(t *testing.T, r resource.Resource, got, want any) {
+	t.Helper()
+	diffs, err := testStateValue(t, r, got).Diff(testStateValue(t, r, want))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, diff := range diffs {
+		t.Errorf("%s: got %s, want %s", diff.Path, diff.Value1, diff.Value2)
+	}
+}
+
+// testCreatePlan asks the provider for the plan to create model with r, and
+// decodes it into plan the way Create does. The configuration sets every
+// argument of model, or only the required ones when minimal is set, so that
+// the attributes Render fills in are unknown.
+func testCreatePlan(t *testing.T, r resource.Resource, model any, minimal bool, plan any) tftypes.Value {
+	t.Helper()
+	ctx := context.Background()
+	metadataResp := &resource.MetadataResponse{}
+	r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "render"}, metadataResp)
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	config, err := tftypes.Transform(testStateValue(t, r, model), func(p *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		attribute, err := schemaResp.Schema.AttributeAtTerraformPath(ctx, p)
+		if err != nil || attribute.IsRequired() || attribute.IsOptional() && !minimal {
+			return v, nil
+		}
+		return tftypes.NewValue(v.Type(), nil), nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server, err := testAccProtoV6ProviderFactories["render"]()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Terraform proposes the configuration itself when there is no prior
+	// state.
+	var values [2]tfprotov6.DynamicValue
+	for i, value := range []tftypes.Value{tftypes.NewValue(config.Type(), nil), config} {
+		if values[i], err = tfprotov6.NewDynamicValue(value.Type(), value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	resp, err := server.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+		TypeName:         metadataResp.TypeName,
+		PriorState:       &values[0],
+		ProposedNewState: &values[1],
+		Config:           &values[1],
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, diag := range resp.Diagnostics {
+		if diag.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Fatalf("%s: %s", diag.Summary, diag.Detail)
+		}
+	}
+	planned, err := resp.PlannedState.Unmarshal(config.Type())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diags := (tfsdk.Plan{Schema: schemaResp.Schema, Raw: planned}).Get(ctx, plan); diags.HasError() {
+		t.Fatalf("unable to decode the plan: %v", diags)
+	}
+	return planned
+}
+
+// testCheckApplied checks that the model read back after applying planned is
+// what Terraform accepts: known planned values are kept, and nothing is left
+// unknown.
+func testCheckApplied(t *testing.T, r resource.Resource, planned tftypes.Value, applied any) {
+	t.Helper()
+	state := testStateValue(t, r, applied)
+	err := tftypes.Walk(planned, func(p *tftypes.AttributePath, v tftypes.Value) (bool, error) {
+		if !v.IsFullyKnown() {
+			return true, nil
+		}
+		got, _, err := tftypes.WalkAttributePath(state, p)
+		if err != nil || !v.Equal(got.(tftypes.Value)) {
+			t.Errorf("%s: planned %s, got %v", p, v, got)
+		}
+		return false, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = tftypes.Walk(state, func(p *tftypes.AttributePath, v tftypes.Value) (bool, error) {
+		if !v.IsKnown() {
+			t.Errorf("%s: unknown after apply", p)
+		}
+		return v.IsKnown(), nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testCheckDataSourceDetails(t *testing.T, got, want map[string]any) {
+	t.Helper()
+	for name, value := range want {
+		if !reflect.DeepEqual(got[name], value) {
+			t.Errorf("%s: got %+v, want %+v", name, got[name], value)
+		}
+	}
+}
+
+func TestServiceConversionRoundTrip(t *testing.T) {
+	tests := map[string]func(t *testing.T, env string){
+		"web_service": func(t *testing.T, env string) {
+			model := testWebServiceModel(env)
+			service := testRenderResponse(webServiceConversion.makeData(&model))
+
+			read := model
+			webServiceConversion.makeModel(&read, service)
+			testCheckModel(t, NewWebService(), read, model)
+
+			imported := WebServiceModel{ID: model.ID, WaitForDeploy: model.WaitForDeploy, Timeouts: model.Timeouts}
+			webServiceConversion.makeModel(&imported, service)
+			testCheckModel(t, NewWebService(), imported, model)
+
+			var dataSource ServiceDataSourceModel
+			makeWebServiceDataSourceModel(&dataSource, service)
+			details := dataSource.ServiceDetails.(WebServiceDetailsDataSource)
+			testCheckDataSourceDetails(t, map[string]any{
+				"id":                         dataSource.ID,
+				"build_filter":               dataSource.BuildFilter,
+				"environment_variables":      dataSource.EnvVars,
+				"autoscaling":                details.Autoscaling,
+				"disk":                       details.Disk,
+				"docker_details":             details.DockerDetails,
+				"native_environment_details": details.NativeEnvironmentDetails,
+				"health_check_path":          details.HealthCheckPath,
+				"url":                        details.URL,
+			}, map[string]any{
+				"id":                         model.ID,
+				"build_filter":               model.BuildFilter,
+				"environment_variables":      testDataSourceEnvVars(model.EnvVars),
+				"autoscaling":                model.ServiceDetails.Autoscaling,
+				"disk":                       model.ServiceDetails.Disk,
+				"docker_details":             model.ServiceDetails.DockerDetails,
+				"native_environment_details": model.ServiceDetails.NativeEnvironmentDetails,
+				"health_check_path":          model.ServiceDetails.HealthCheckPath,
+				"url":                        model.ServiceDetails.URL,
+			})
+		},
+		"private_service": func(t *testing.T, env string) {
+			model := testPrivateServiceModel(env)
+			service := testRenderResponse(privateServiceConversion.makeData(&model))
+
+			read := model
+			privateServiceConversion.makeModel(&read, service)
+			testCheckModel(t, NewPrivateService(), read, model)
+
+			imported := PrivateServiceModel{ID: model.ID, WaitForDeploy: model.WaitForDeploy, Timeouts: model.Timeouts}
+			privateServiceConversion.makeModel(&imported, service)
+			testCheckModel(t, NewPrivateService(), imported, model)
+
+			var dataSource ServiceDataSourceModel
+			makePrivateServiceDataSourceModel(&dataSource, service)
+			details := dataSource.ServiceDetails.(PrivateServiceDetailsDataSource)
+			testCheckDataSourceDetails(t, map[string]any{
+				"id":                         dataSource.ID,
+				"build_filter":               dataSource.BuildFilter,
+				"environment_variables":      dataSource.EnvVars,
+				"autoscaling":                details.Autoscaling,
+				"disk":                       details.Disk,
+				"docker_details":             details.DockerDetails,
+				"native_environment_details": details.NativeEnvironmentDetails,
+				"url":                        details.URL,
+			}, map[string]any{
+				"id":                         model.ID,
+				"build_filter":               model.BuildFilter,
+				"environment_variables":      testDataSourceEnvVars(model.EnvVars),
+				"autoscaling":                model.ServiceDetails.Autoscaling,
+				"disk":                       model.ServiceDetails.Disk,
+				"docker_details":             model.ServiceDetails.DockerDetails,
+				"native_environment_details": model.ServiceDetails.NativeEnvironmentDetails,
+				"url":                        model.ServiceDetails.URL,
+			})
+		},
+		"background_worker": func(t *testing.T, env string) {
+			model := testBackgroundWorkerModel(env)
+			service := testRenderResponse(backgroundWorkerConversion.makeData(&model))
+
+			read := model
+			backgroundWorkerConversion.makeModel(&read, service)
+			testCheckModel(t, NewBackgroundWorker(), read, model)
+
+			imported := BackgroundWorkerModel{ID: model.ID, WaitForDeploy: model.WaitForDeploy, Timeouts: model.Timeouts}
+			backgroundWorkerConversion.makeModel(&imported, service)
+			testCheckModel(t, NewBackgroundWorker(), imported, model)
+
+			var dataSource ServiceDataSourceModel
+			makeBackgroundWorkerDataSourceModel(&dataSource, service)
+			details := dataSource.ServiceDetails.(BackgroundWorkerDetailsDataSource)
+			testCheckDataSourceDetails(t, map[string]any{
+				"id":                         dataSource.ID,
+				"build_filter":               dataSource.BuildFilter,
+				"environment_variables":      dataSource.EnvVars,
+				"autoscaling":                details.Autoscaling,
+				"disk":                       details.Disk,
+				"docker_details":             details.DockerDetails,
+				"native_environment_details": details.NativeEnvironmentDetails,
+			}, map[string]any{
+				"id":                         model.ID,
+				"build_filter":               model.BuildFilter,
+				"environment_variables":      testDataSourceEnvVars(model.EnvVars),
+				"autoscaling":                model.ServiceDetails.Autoscaling,
+				"disk":                       model.ServiceDetails.Disk,
+				"docker_details":             model.ServiceDetails.DockerDetails,
+				"native_environment_details": model.ServiceDetails.NativeEnvironmentDetails,
+			})
+		},
+		"cron_job": func(t *testing.T, env string) {
+			model := testCronJobModel(env)
+			service := testRenderResponse(cronJobConversion.makeData(&model))
+
+			read := model
+			cronJobConversion.makeModel(&read, service)
+			testCheckModel(t, NewCronJob(), read, model)
+
+			imported := CronJobModel{ID: model.ID, WaitForDeploy: model.WaitForDeploy, Timeouts: model.Timeouts}
+			cronJobConversion.makeModel(&imported, service)
+			testCheckModel(t, NewCronJob(), imported, model)
+		},
+	}
+
+	for name, test := range tests {
+		for _, env := range serviceEnvs {
+			t.Run(name+"/"+env, func(t *testing.T) {
+				test(t, env)
+			})
+		}
+	}
+}
+
+func TestServiceConversionCreatePlan(t *testing.T) {
+	tests := map[string]func(t *testing.T, env string, minimal bool){
+		"web_service": func(t *testing.T, env string, minimal bool) {
+			var plan WebServiceModel
+			planned := testCreatePlan(t, NewWebService(), testWebServiceModel(env), minimal, &plan)
+			webServiceConversion.makeModel(&plan, testRenderResponse(webServiceConversion.makeData(&plan)))
+			testCheckApplied(t, NewWebService(), planned, plan)
+		},
+		"private_service": func(t *testing.T, env string, minimal bool) {
+			var plan PrivateServiceModel
+			planned := testCreatePlan(t, NewPrivateService(), testPrivateServiceModel(env), minimal, &plan)
+			privateServiceConversion.makeModel(&plan, testRenderResponse(privateServiceConversion.makeData(&plan)))
+			testCheckApplied(t, NewPrivateService(), planned, plan)
+		},
+		"background_worker": func(t *testing.T, env string, minimal bool) {
+			var plan BackgroundWorkerModel
+			planned := testCreatePlan(t, NewBackgroundWorker(), testBackgroundWorkerModel(env), minimal, &plan)
+			backgroundWorkerConversion.makeModel(&plan, testRenderResponse(backgroundWorkerConversion.makeData(&plan)))
+			testCheckApplied(t, NewBackgroundWorker(), planned, plan)
+		},
+		"cron_job": func(t *testing.T, env string, minimal bool) {
+			var plan CronJobModel
+			planned := testCreatePlan(t, NewCronJob(), testCronJobModel(env), minimal, &plan)
+			cronJobConversion.makeModel(&plan, testRenderResponse(cronJobConversion.makeData(&plan)))
+			testCheckApplied(t, NewCronJob(), planned, plan)
+		},
+	}
+
+	for name, test := range tests {
+		for _, env := range serviceEnvs {
+			t.Run(name+"/"+env, func(t *testing.T) {
+				test(t, env, false)
+			})
+			t.Run(name+"/"+env+"/minimal", func(t *testing.T) {
+				test(t, env, true)
+			})
+		}
+	}
+}
+
+func TestServiceConversionUnknownValues(t *testing.T) {
+	model := testWebServiceModel("docker")
+	model.ServiceDetails.DockerDetails.DockerContext = types.StringUnknown()
+	model.ServiceDetails.DockerDetails.RegistryCredentialId = types.StringNull()
+
+	details := webServiceConversion.makeData(&model).ServiceDetails.EnvSpecificDetails
+	if details == nil {
+		t.Fatal("expected docker details")
+	}
+	if details.DockerContext != nil || details.RegistryCredentialId != nil {
+		t.Errorf("expected unknown and null values to be left out, got %+v", details)
+	}
+	if details.DockerfilePath == nil || *details.DockerfilePath != "./Dockerfile" {
+		t.Errorf("expected the dockerfile path to be sent, got %+v", details)
+	}
+}
+
+func TestServiceConversionOmitsUnconfiguredBlocks(t *testing.T) {
+	model := testWebServiceModel("node")
+	service := testRenderResponse(webServiceConversion.makeData(&model))
+
+	// A disk and build filter that are not configured are not tracked.
+	model.ServiceDetails.Disk = nil
+	model.BuildFilter = nil
+	webServiceConversion.makeModel(&model, service)
+	if model.ServiceDetails.Disk != nil {
+		t.Errorf("expected the disk to be left out, got %+v", model.ServiceDetails.Disk)
+	}
+	if model.BuildFilter != nil {
+		t.Errorf("expected the build filter to be left out, got %+v", model.BuildFilter)
+	}
+
+	service.ServiceDetails.Autoscaling.Criteria = nil
+	webServiceConversion.makeModel(&model, service)
+	if model.ServiceDetails.Autoscaling == nil || model.ServiceDetails.Autoscaling.Criteria.CPU.Enabled.ValueBool() {
+		t.Errorf("expected autoscaling without criteria, got %+v", model.ServiceDetails.Autoscaling)
+	}
+}
+
+func TestServiceConversionUnconfiguredSecretFiles(t *testing.T) {
+	model := testWebServiceModel("node")
+	model.SecretFiles = types.ListUnknown(secretFileType)
+
+	// Secret files that are not configured are left alone, so that they can
+	// be managed with render_service_secret_file.
+	if secretFiles := webServiceConversion.makeData(&model).SecretFiles; secretFiles != nil {
+		t.Errorf("expected no secret files to be sent, got %+v", secretFiles)
+	}
+
+	service := testRenderResponse(webServiceConversion.makeData(&model))
+	service.SecretFiles = []render.SecretFiles{{Name: "credentials.json", Contents: "{}"}}
+	webServiceConversion.makeModel(&model, service)
+	if !model.SecretFiles.Equal(secretFilesValue(service.SecretFiles)) {
+		t.Errorf("expected the secret files to be read, got %s", model.SecretFiles)
+	}
+}
+
+func TestEnvVarsOrder(t *testing.T) {
+	envVars := []render.EnvironmentVariable{{Key: "B", Value: "2"}, {Key: "A", Value: "1"}, {Key: "C", Value: "3"}}
+	reversed := []render.EnvironmentVariable{envVars[2], envVars[1], envVars[0]}
+
+	if !reflect.DeepEqual(makeEnvVarsModel(nil, envVars), makeEnvVarsModel(nil, reversed)) {
+		t.Errorf("expected the order of environment variables not to matter")
+	}
+
+	data := makeEnvVarsData(makeEnvVarsModel(nil, envVars))
+	var keys []string
+	for _, envVar := range data {
+		keys = append(keys, envVar.Key)
+	}
+	if !reflect.DeepEqual(keys, []string{"A", "B", "C"}) {
+		t.Errorf("expected environment variables sorted by name, got %v", keys)
+	}
+}
+
+func TestEnvVarsGeneratedAndIgnoredValues(t *testing.T) {
+	plan := map[string]EnvironmentVariable{
+		"NEW_SECRET": {Value: types.StringUnknown(), GenerateValue: types.BoolValue(true), IgnoreValue: types.BoolValue(false)},
+		"SECRET":     {Value: types.StringValue("s3cr3t"), GenerateValue: types.BoolValue(true), IgnoreValue: types.BoolValue(false)},
+		"TOKEN":      {Value: types.StringValue(""), GenerateValue: types.BoolValue(false), IgnoreValue: types.BoolValue(true)},
+	}
+
+	data := makeEnvVarsData(plan)
+	if len(data) != 3 {
+		t.Fatalf("expected 3 environment variables, got %+v", data)
+	}
+	if !data[0].GenerateValue || data[0].Value != nil {
+		t.Errorf("expected Render to generate a new value, got %+v", data[0])
+	}
+	if data[1].GenerateValue || data[1].Value == nil || *data[1].Value != "s3cr3t" {
+		t.Errorf("expected the generated value to be kept, got %+v", data[1])
+	}
+	if data[2].Value == nil || *data[2].Value != "" {
+		t.Errorf("expected an empty value, got %+v", data[2])
+	}
+
+	// Render doesn't know how values were set, so the flags come from the
+	// current state, and the values from Render.
+	envVars := []render.EnvironmentVariable{{Key: "TOKEN", Value: "rotated"}, {Key: "SECRET", Value: "s3cr3t"}, {Key: "NEW_SECRET", Value: "generated"}}
+	model := makeEnvVarsModel(plan, envVars)
+	if !model["NEW_SECRET"].GenerateValue.ValueBool() || model["NEW_SECRET"].Value.ValueString() != "generated" {
+		t.Errorf("unexpected generated environment variable: %+v", model["NEW_SECRET"])
+	}
+	if !model["TOKEN"].IgnoreValue.ValueBool() || model["TOKEN"].Value.ValueString() != "rotated" {
+		t.Errorf("unexpected ignored environment variable: %+v", model["TOKEN"])
+	}
+
+	imported := makeEnvVarsModel(nil, envVars)
+	if imported["SECRET"].GenerateValue.ValueBool() || imported["TOKEN"].IgnoreValue.ValueBool() {
+		t.Errorf("expected imported environment variables to be fully managed, got %+v", imported)
+	}
+}
+
+func testStaticSiteModel() StaticSiteModel {
+	return StaticSiteModel{
+		AutoDeploy:   types.StringValue("yes"),
+		Branch:       types.StringValue("main"),
+		BuildFilter:  testBuildFilter(),
+		EnvVars:      testEnvVars(),
+		ID:           types.StringValue(testServiceID),
+		Name:         types.StringValue("my-site"),
+		OwnerID:      types.StringValue(testOwnerID),
+		Repo:         types.StringValue("https://github.com/render-examples/create-react-app"),
+		RootDir:      types.StringValue("web"),
+		Type:         types.StringValue("static_site"),
+		CreateAt:     types.StringValue("2024-03-01T12:00:00Z"),
+		NotifyOnFail: types.StringValue("default"),
+		Slug:         types.StringValue("my-service"),
+		Suspended:    types.StringValue("not_suspended"),
+		Suspenders:   stringListValue(nil),
+		UpdatedAt:    types.StringValue("2024-03-02T12:00:00Z"),
+		ServiceDetails: &StaticSiteDetails{
+			BuildCommand:               types.StringValue("npm run build"),
+			Headers:                    headersValue([]render.Header{{Path: "/*", Name: "X-Frame-Options", Value: "DENY"}}),
+			ParentServer:               parentServerValue(&render.ParentServer{ID: "srv-parent", Name: "parent"}),
+			PublishPath:                types.StringValue("build"),
+			PullRequestPreviewsEnabled: types.StringValue("no"),
+			Routes:                     routesValue([]render.Route{{Type: "rewrite", Source: "/*", Destination: "/index.html"}}),
+			URL:                        types.StringValue("https://my-site.onrender.com"),
+		},
+	}
+}
+
+// testStaticSiteRenderResponse is like testRenderResponse, for a static site
+// created from data. Render publishes the site from `public` unless told
+// otherwise.
+func testStaticSiteRenderResponse(data *api.StaticSiteData) *api.StaticSite {
+	service := testRenderResponse(&api.ServiceData{Service: data.Service, EnvVars: data.EnvVars})
+	staticSite := api.StaticSite{Service: *service, ServiceDetails: data.ServiceDetails}
+	staticSite.ServiceDetails.ParentServer = &render.ParentServer{ID: "srv-parent", Name: "parent"}
+	staticSite.ServiceDetails.URL = "https://my-site.onrender.com"
+	if staticSite.ServiceDetails.PublishPath == "" {
+		staticSite.ServiceDetails.PublishPath = "public"
+	}
+	if staticSite.ServiceDetails.Headers == nil {
+		staticSite.ServiceDetails.Headers = []render.Header{}
+	}
+	if staticSite.ServiceDetails.Routes == nil {
+		staticSite.ServiceDetails.Routes = []render.Route{}
+	}
+	return &staticSite
+}
+
+func TestStaticSiteConversion(t *testing.T) {
+	t.Run("round_trip", func(t *testing.T) {
+		model := testStaticSiteModel()
+		staticSite := testStaticSiteRenderResponse(makeStaticSiteData(&model))
+
+		read := model
+		makeStaticSiteModel(&read, staticSite)
+		testCheckModel(t, &StaticSite{}, read, model)
+
+		imported := StaticSiteModel{ID: model.ID}
+		makeStaticSiteModel(&imported, staticSite)
+		testCheckModel(t, &StaticSite{}, imported, model)
+	})
+
+	for _, minimal := range []bool{false, true} {
+		name := "create_plan"
+		if minimal {
+			name += "/minimal"
+		}
+		t.Run(name, func(t *testing.T) {
+			var plan StaticSiteModel
+			planned := testCreatePlan(t, &StaticSite{}, testStaticSiteModel(), minimal, &plan)
+			makeStaticSiteModel(&plan, testStaticSiteRenderResponse(makeStaticSiteData(&plan)))
+			testCheckApplied(t, &StaticSite{}, planned, plan)
+		})
+	}
+}
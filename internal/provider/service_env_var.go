@@ -0,0 +1,235 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sonlir/render-client-go"
+
+	"terraform-provider-render/internal/api"
+)
+
+var (
+	_ resource.Resource                = &ServiceEnvVar{}
+	_ resource.ResourceWithConfigure   = &ServiceEnvVar{}
+	_ resource.ResourceWithImportState = &ServiceEnvVar{}
+	_ resource.ResourceWithModifyPlan  = &ServiceEnvVar{}
+)
+
+func NewServiceEnvVar() resource.Resource {
+	return &ServiceEnvVar{}
+}
+
+type ServiceEnvVar struct {
+	client *api.Client
+}
+
+type ServiceEnvVarModel struct {
+	ID            types.String `tfsdk:"id"`
+	ServiceID     types.String `tfsdk:"service_id"`
+	Key           types.String `tfsdk:"key"`
+	Value         types.String `tfsdk:"value"`
+	GenerateValue types.Bool   `tfsdk:"generate_value"`
+	IgnoreValue   types.Bool   `tfsdk:"ignore_value"`
+}
+
+func (r *ServiceEnvVar) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_env_var"
+}
+
+func (r *ServiceEnvVar) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := environmentVariableAttributes()
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "The ID of the environment variable, in the form `<service_id>/<key>`",
+		Computed:            true,
+		PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+	}
+	attributes["service_id"] = schema.StringAttribute{
+		MarkdownDescription: "The ID of the service",
+		Required:            true,
+		PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+	}
+	attributes["key"] = schema.StringAttribute{
+		MarkdownDescription: "The name of the environment variable",
+		Required:            true,
+		PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a single environment variable of a service and leaves the other variables alone, so that several configurations can add variables to the same service. " +
+			"Leave `environment_variables` unset on the service resource, which then leaves its variables alone.",
+		Attributes: attributes,
+	}
+}
+
+func (r *ServiceEnvVar) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planUnchangedEnvVars(req, resp)
+}
+
+func (r *ServiceEnvVar) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*render.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = api.NewClient(client)
+}
+
+func (r *ServiceEnvVar) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ServiceEnvVarModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The endpoint creates or updates the variable, so an existing variable
+	// would silently be taken over.
+	_, err := r.client.GetServiceEnvVar(plan.ServiceID.ValueString(), plan.Key.ValueString())
+	if err == nil {
+		resp.Diagnostics.AddError(
+			"Error creating Render service environment variable",
+			"Environment variable "+plan.Key.ValueString()+" already exists on service ID: "+plan.ServiceID.ValueString()+". Import it to manage it with Terraform.",
+		)
+		return
+	}
+	if !api.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error creating Render service environment variable",
+			"Could not get environment variable "+plan.Key.ValueString()+" of service ID: "+plan.ServiceID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	envVar, err := r.client.UpdateServiceEnvVar(plan.ServiceID.ValueString(), plan.Key.ValueString(), makeEnvVarValueData(plan.Value, plan.GenerateValue))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Render service environment variable",
+			"Could not create environment variable "+plan.Key.ValueString()+" on service ID: "+plan.ServiceID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	makeServiceEnvVarModel(&plan, envVar)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ServiceEnvVar) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ServiceEnvVarModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	envVar, err := r.client.GetServiceEnvVar(state.ServiceID.ValueString(), state.Key.ValueString())
+	if api.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not get Render service environment variable: "+state.ID.ValueString(),
+			err.Error(),
+		)
+		return
+	}
+
+	makeServiceEnvVarModel(&state, envVar)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ServiceEnvVar) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ServiceEnvVarModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	envVar, err := r.client.UpdateServiceEnvVar(plan.ServiceID.ValueString(), plan.Key.ValueString(), makeEnvVarValueData(plan.Value, plan.GenerateValue))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Render service environment variable",
+			"Could not update environment variable "+plan.Key.ValueString()+" on service ID: "+plan.ServiceID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	makeServiceEnvVarModel(&plan, envVar)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ServiceEnvVar) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ServiceEnvVarModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteServiceEnvVar(state.ServiceID.ValueString(), state.Key.ValueString())
+	if err != nil && !api.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting Render service environment variable",
+			"Could not delete environment variable "+state.Key.ValueString()+" from service ID: "+state.ServiceID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *ServiceEnvVar) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serviceID, key, ok := strings.Cut(req.ID, "/")
+	if !ok || serviceID == "" || key == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <service_id>/<key>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), serviceID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), key)...)
+}
+
+// makeServiceEnvVarModel sets the value read from Render. Like for the
+// variables of a service, generate_value and ignore_value are kept from state.
+func makeServiceEnvVarModel(state *ServiceEnvVarModel, envVar *render.EnvironmentVariable) {
+	state.ID = types.StringValue(state.ServiceID.ValueString() + "/" + state.Key.ValueString())
+	state.Value = types.StringValue(envVar.Value)
+	state.GenerateValue = types.BoolValue(state.GenerateValue.ValueBool())
+	state.IgnoreValue = types.BoolValue(state.IgnoreValue.ValueBool())
+}
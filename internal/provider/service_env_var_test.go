@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/sonlir/render-client-go"
+
+	"terraform-provider-render/internal/fakerender"
+)
+
+func TestServiceEnvVarResource(t *testing.T) {
+	server, providerConfig := newTestServer(t)
+
+	var serviceID string
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckServiceDestroy(server, "render_web_service"),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testServiceEnvVarConfig("my-app", "info"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("render_web_service.test", "id", func(value string) error {
+						serviceID = value
+						return nil
+					}),
+					resource.TestCheckResourceAttrPair("render_service_env_var.log_level", "service_id", "render_web_service.test", "id"),
+					resource.TestCheckResourceAttr("render_service_env_var.log_level", "value", "info"),
+					resource.TestCheckResourceAttrSet("render_service_env_var.secret", "value"),
+					testCheckServiceEnvVars(server, "render_web_service.test", map[string]string{"LOG_LEVEL": "info"}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "render_service_env_var.log_level",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing: the other variables are left alone
+			{
+				Config: providerConfig + testServiceEnvVarConfig("my-app", "debug"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("render_service_env_var.log_level", "value", "debug"),
+					testCheckServiceEnvVars(server, "render_web_service.test", map[string]string{"LOG_LEVEL": "debug"}),
+				),
+			},
+			// Updating the service leaves the variables alone as well
+			{
+				Config: providerConfig + testServiceEnvVarConfig("my-app-renamed", "debug"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("render_web_service.test", "name", "my-app-renamed"),
+					resource.TestCheckNoResourceAttr("render_web_service.test", "environment_variables"),
+					testCheckServiceEnvVars(server, "render_web_service.test", map[string]string{"LOG_LEVEL": "debug"}),
+				),
+			},
+			// A variable deleted outside of Terraform is created again
+			{
+				PreConfig: func() {
+					server.UpdateService(serviceID, func(service *render.Service) {
+						for i, envVar := range service.EnvVars {
+							if envVar.Key == "LOG_LEVEL" {
+								service.EnvVars = append(service.EnvVars[:i], service.EnvVars[i+1:]...)
+								break
+							}
+						}
+					})
+				},
+				Config: providerConfig + testServiceEnvVarConfig("my-app-renamed", "debug"),
+				Check:  testCheckServiceEnvVars(server, "render_web_service.test", map[string]string{"LOG_LEVEL": "debug"}),
+			},
+		},
+	})
+}
+
+func TestServiceEnvVarResourceExisting(t *testing.T) {
+	server, providerConfig := newTestServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckServiceDestroy(server, "render_web_service"),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testServiceEnvVarConfig("my-app", "info"),
+			},
+			// Variables that already exist are not taken over
+			{
+				Config: providerConfig + testServiceEnvVarConfig("my-app", "info") + `
+resource "render_service_env_var" "existing" {
+  service_id = render_web_service.test.id
+  key        = "LOG_LEVEL"
+  value      = "debug"
+}
+`,
+				ExpectError: regexp.MustCompile("already exists"),
+			},
+		},
+	})
+}
+
+// testServiceEnvVarConfig returns a web service that leaves its environment
+// variables to render_service_env_var.
+func testServiceEnvVarConfig(name, logLevel string) string {
+	return fmt.Sprintf(`
+resource "render_web_service" "test" {
+  name     = %q
+  owner_id = %q
+  repo     = "https://github.com/render-examples/express-hello-world"
+
+  service_details = {
+    env           = "node"
+    num_instances = 1
+    native_environment_details = {
+      build_command = "yarn"
+      start_command = "node app.js"
+    }
+  }
+
+}
+
+resource "render_service_env_var" "log_level" {
+  service_id = render_web_service.test.id
+  key        = "LOG_LEVEL"
+  value      = %q
+}
+
+resource "render_service_env_var" "secret" {
+  service_id     = render_web_service.test.id
+  key            = "SESSION_SECRET"
+  generate_value = true
+}
+`, name, testOwnerID, logLevel)
+}
+
+// testCheckServiceEnvVars checks that the service has the given environment
+// variables, among others.
+func testCheckServiceEnvVars(server *fakerender.Server, name string, expected map[string]string) resource.TestCheckFunc {
+	return testCheckService(server, name, func(service render.Service) error {
+		envVars := map[string]string{}
+		for _, envVar := range service.EnvVars {
+			envVars[envVar.Key] = envVar.Value
+		}
+		for key, value := range expected {
+			if envVars[key] != value {
+				return fmt.Errorf("expected %s to be %q, got %+v", key, value, envVars)
+			}
+		}
+		return nil
+	})
+}
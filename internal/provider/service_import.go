@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sonlir/render-client-go"
+
+	"terraform-provider-render/internal/api"
+)
+
+// findServiceID resolves the import identifier of a service of the given type
+// to its ID. The identifier is either a service ID, `<owner_id>/<name>`, or
+// the slug of the service. The IDs of cron jobs start with `crn-` instead of
+// `srv-`.
+func findServiceID(client *api.Client, serviceType, importID string) (string, error) {
+	if (strings.HasPrefix(importID, "srv-") || strings.HasPrefix(importID, "crn-")) && !strings.Contains(importID, "/") {
+		return importID, nil
+	}
+
+	var matches []render.Service
+	if ownerID, name, ok := strings.Cut(importID, "/"); ok {
+		if ownerID == "" || name == "" {
+			return "", fmt.Errorf("expected import identifier with format: <service_id>, <owner_id>/<name> or <slug>. Got: %q", importID)
+		}
+		services, err := client.ListServices(&api.ListServicesArgs{Name: name, OwnerID: ownerID, Type: serviceType})
+		if err != nil {
+			return "", err
+		}
+		for _, service := range services {
+			if service.OwnerID == ownerID && service.Name == name {
+				matches = append(matches, service)
+			}
+		}
+	} else {
+		services, err := client.ListServices(&api.ListServicesArgs{Type: serviceType})
+		if err != nil {
+			return "", err
+		}
+		for _, service := range services {
+			if service.Slug == importID {
+				matches = append(matches, service)
+			}
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("no %s matches %q", serviceType, importID)
+	case 1:
+		return matches[0].ID, nil
+	}
+
+	ids := []string{}
+	for _, service := range matches {
+		ids = append(ids, service.ID)
+	}
+	return "", fmt.Errorf("%q matches %d services of type %s: %s. Import one of them by ID instead", importID, len(matches), serviceType, strings.Join(ids, ", "))
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sonlir/render-client-go"
+
+	"terraform-provider-render/internal/api"
+)
+
+func TestFindServiceID(t *testing.T) {
+	services := []render.Service{
+		{ID: "srv-1", Name: "api", OwnerID: "tea-1", Slug: "api-x1y2"},
+		{ID: "srv-2", Name: "api", OwnerID: "tea-2", Slug: "api-a3b4"},
+		{ID: "srv-3", Name: "web", OwnerID: "tea-1", Slug: "web"},
+		{ID: "srv-4", Name: "web", OwnerID: "tea-1", Slug: "web-c5d6"},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("type") != "web_service" {
+			t.Errorf("expected services to be filtered by type, got: %s", r.URL.RawQuery)
+		}
+		page := []api.ServicesPage{}
+		for _, service := range services {
+			if name := r.URL.Query().Get("name"); name != "" && service.Name != name {
+				continue
+			}
+			if ownerID := r.URL.Query().Get("ownerId"); ownerID != "" && service.OwnerID != ownerID {
+				continue
+			}
+			page = append(page, api.ServicesPage{Cursor: service.ID, Service: service})
+		}
+		_ = json.NewEncoder(w).Encode(page)
+	}))
+	defer server.Close()
+
+	apiKey := "rnd_test"
+	client, err := render.NewClient(&apiKey, &server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		importID string
+		wantID   string
+		wantErr  bool
+	}{
+		"service id":       {importID: "srv-cabcdefghijklmnopqest", wantID: "srv-cabcdefghijklmnopqest"},
+		"cron job id":      {importID: "crn-cabcdefghijklmnopqest", wantID: "crn-cabcdefghijklmnopqest"},
+		"owner and name":   {importID: "tea-2/api", wantID: "srv-2"},
+		"slug":             {importID: "api-x1y2", wantID: "srv-1"},
+		"no match":         {importID: "tea-3/api", wantErr: true},
+		"unknown slug":     {importID: "worker", wantErr: true},
+		"several matches":  {importID: "tea-1/web", wantErr: true},
+		"missing owner id": {importID: "/api", wantErr: true},
+		"missing name":     {importID: "tea-1/", wantErr: true},
+	}
+
+	for name, test := range tests {
+		id, err := findServiceID(api.NewClient(client), "web_service", test.importID)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("%s: expected an error, got ID: %s", name, id)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", name, err)
+			continue
+		}
+		if id != test.wantID {
+			t.Errorf("%s: expected ID %s, got: %s", name, test.wantID, id)
+		}
+	}
+}
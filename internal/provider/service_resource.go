@@ -0,0 +1,578 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sonlir/render-client-go"
+
+	"terraform-provider-render/internal/api"
+)
+
+// The web service, private service, background worker and cron job resources
+// are all implemented by serviceResource, so that they validate, read and
+// update services the same way.
+
+// validateEnvSpecificDetails checks that only the block matching the runtime
+// of the service is configured: docker_details for the docker runtime, and
+// native_environment_details for the native ones.
+func validateEnvSpecificDetails(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var env types.String
+	var dockerDetails, nativeEnvironmentDetails types.Object
+	diags.Append(config.GetAttribute(ctx, path.Root("service_details").AtName("env"), &env)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("service_details").AtName("docker_details"), &dockerDetails)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("service_details").AtName("native_environment_details"), &nativeEnvironmentDetails)...)
+	if diags.HasError() || env.IsNull() || env.IsUnknown() {
+		return diags
+	}
+
+	if !dockerDetails.IsNull() && env.ValueString() != "docker" {
+		diags.AddAttributeError(
+			path.Root("service_details").AtName("docker_details"),
+			"Invalid Environment Details",
+			"docker_details can only be set when env is docker, got: "+env.ValueString(),
+		)
+	}
+	if !nativeEnvironmentDetails.IsNull() && (env.ValueString() == "docker" || env.ValueString() == "image") {
+		diags.AddAttributeError(
+			path.Root("service_details").AtName("native_environment_details"),
+			"Invalid Environment Details",
+			"native_environment_details can't be set when env is "+env.ValueString()+", it is only used by native runtimes",
+		)
+	}
+	return diags
+}
+
+// readServiceSecretFiles sets the secret files of service. Render leaves them
+// out of services, so they are read separately whenever a service is created,
+// read or updated.
+func readServiceSecretFiles(client *api.Client, service *render.Service) error {
+	secretFiles, err := client.GetServiceSecretFiles(service.ID)
+	if err != nil {
+		return err
+	}
+	service.SecretFiles = secretFiles
+	return nil
+}
+
+// serviceResource implements the web service, private service, background
+// worker and cron job resources. They only differ in their schema and in the
+// conversion of their service details.
+type serviceResource[D any] struct {
+	client     *api.Client
+	conversion serviceConversion[D]
+}
+
+// name returns the type of the service for messages, e.g. "web service".
+func (r *serviceResource[D]) name() string {
+	return strings.ReplaceAll(r.conversion.serviceType, "_", " ")
+}
+
+func (r *serviceResource[D]) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.conversion.serviceType
+}
+
+func (r *serviceResource[D]) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(validateEnvSpecificDetails(ctx, req.Config)...)
+}
+
+func (r *serviceResource[D]) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planUnchangedEnvVars(req, resp)
+	warnDiskRemoval(ctx, req, resp)
+}
+
+// warnDiskRemoval warns when the disk block is removed from a service, as the
+// update then deletes the disk along with its data.
+func warnDiskRemoval(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	diskPath := path.Root("service_details").AtName("disk")
+	var stateDisk, planDisk types.Object
+	// Services without disks, like cron jobs, have no such attribute.
+	if diags := req.State.GetAttribute(ctx, diskPath, &stateDisk); diags.HasError() {
+		return
+	}
+	if diags := resp.Plan.GetAttribute(ctx, diskPath, &planDisk); diags.HasError() {
+		return
+	}
+	if stateDisk.IsNull() || !planDisk.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeWarning(
+		diskPath,
+		"Disk Will Be Deleted",
+		"The disk block was removed from the service, so applying this plan deletes the disk of the service and all the data on it. "+
+			"Add the block back to keep the disk.",
+	)
+}
+
+func (r *serviceResource[D]) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*render.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = api.NewClient(client)
+}
+
+func (r *serviceResource[D]) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ServiceResourceModel[D]
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := r.conversion.makeData(&plan)
+
+	service, err := r.client.CreateService(*data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Render "+r.name(),
+			"Could not create "+r.name()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	service.ServiceDetails.Disk, err = r.client.GetServiceDisk(service)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Render "+r.name(),
+			"Could not get disk for "+r.name()+" ID: "+service.ID+": "+err.Error(),
+		)
+		return
+	}
+
+	err = readServiceSecretFiles(r.client, service)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Render "+r.name(),
+			"Could not get secret files for "+r.name()+" ID: "+service.ID+": "+err.Error(),
+		)
+		return
+	}
+
+	r.conversion.makeModel(&plan, service)
+
+	if plan.WaitForDeploy.ValueBool() {
+		createTimeout, diags := plan.Timeouts.Create(ctx, deployTimeout)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(r.waitForDeploy(ctx, service.ID, "", createTimeout)...)
+		}
+		if resp.Diagnostics.HasError() {
+			// Keep the service in state, so that it is not orphaned.
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *serviceResource[D]) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ServiceResourceModel[D]
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service, err := r.client.GetService(state.ID.ValueString())
+	if api.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not get Render "+r.name()+": "+state.ID.ValueString(),
+			err.Error(),
+		)
+		return
+	}
+
+	service.ServiceDetails.Disk, err = r.client.GetServiceDisk(service)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not get Render "+r.name()+" disk: "+state.ID.ValueString(),
+			err.Error(),
+		)
+		return
+	}
+
+	err = readServiceSecretFiles(r.client, service)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not get Render "+r.name()+" secret files: "+state.ID.ValueString(),
+			err.Error(),
+		)
+		return
+	}
+
+	r.conversion.makeModel(&state, service)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *serviceResource[D]) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ServiceResourceModel[D]
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+
+	data := r.conversion.makeData(&plan)
+	if !envVarsChanged(plan.EnvVars, state.EnvVars) {
+		data.EnvVars = nil
+	}
+
+	previousDeployID := ""
+	var err error
+	if plan.WaitForDeploy.ValueBool() {
+		previousDeployID, err = latestDeployID(r.client, plan.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating Render "+r.name(),
+				"Could not get deploys for "+r.name()+" ID: "+plan.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// The disk is updated through its own endpoints.
+	disk := data.ServiceDetails.Disk
+	data.ServiceDetails.Disk = nil
+	stateDisk := r.conversion.makeData(&state).ServiceDetails.Disk
+
+	service, err := r.client.UpdateService(plan.ID.ValueString(), *data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Render "+r.name(),
+			"Could not update "+r.name()+" ID: "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	// The service is scaled after the update, so the response still has the
+	// previous number of instances.
+	service.ServiceDetails.NumInstances = data.ServiceDetails.NumInstances
+
+	if disk != nil || stateDisk != nil {
+		service.ServiceDetails.Disk, err = r.client.UpdateServiceDisk(plan.ID.ValueString(), disk)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating Render "+r.name(),
+				"Could not update disk for "+r.name()+" ID: "+plan.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	err = readServiceSecretFiles(r.client, service)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Render "+r.name(),
+			"Could not get secret files for "+r.name()+" ID: "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	r.conversion.makeModel(&plan, service)
+
+	if plan.WaitForDeploy.ValueBool() {
+		updateTimeout, diags := plan.Timeouts.Update(ctx, deployTimeout)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(r.waitForDeploy(ctx, plan.ID.ValueString(), previousDeployID, updateTimeout)...)
+		}
+		if resp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *serviceResource[D]) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ServiceResourceModel[D]
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteService(state.ID.ValueString())
+	if err != nil && !api.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting Render "+r.name(),
+			"Could not delete "+r.name()+" ID: "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *serviceResource[D]) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := findServiceID(r.client, r.conversion.serviceType, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing Render "+r.name(),
+			"Could not find "+r.name()+" "+req.ID+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_deploy"), false)...)
+}
+
+func (r *serviceResource[D]) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return envVarsStateUpgraders()
+}
+
+// waitForDeploy waits until a deploy newer than previousDeployID is live.
+func (r *serviceResource[D]) waitForDeploy(ctx context.Context, serviceID, previousDeployID string, timeout time.Duration) (diags diag.Diagnostics) {
+	deploy, err := waitForLatestDeploy(ctx, r.client, serviceID, previousDeployID, timeout)
+	if err != nil {
+		diags.AddError(
+			"Error waiting for Render "+r.name()+" deploy",
+			"Could not wait for the deploy of "+r.name()+" ID: "+serviceID+": "+err.Error(),
+		)
+		return diags
+	}
+	if deploy.Status != deployLive {
+		diags.AddError(
+			"Render "+r.name()+" deploy failed",
+			fmt.Sprintf("Deploy %s of %s ID: %s finished with status: %s", deploy.ID, r.name(), serviceID, deploy.Status),
+		)
+	}
+
+	return diags
+}
+
+// serviceResourceSchema returns the schema of a service resource. The
+// attributes that all service resources have in common are added to the
+// given service details.
+func serviceResourceSchema(ctx context.Context, description string, serviceDetails map[string]schema.Attribute) schema.Schema {
+	serviceDetails["env"] = schema.StringAttribute{
+		MarkdownDescription: "Environment (runtime). Valid values are `node`, `python`, `ruby`, `go`, `elixir`, `image`, `rust`, `docker`.",
+		Required:            true,
+		Validators:          []validator.String{stringvalidator.OneOf(serviceEnvs...)},
+	}
+	serviceDetails["native_environment_details"] = nativeEnvironmentDetailsAttribute()
+	serviceDetails["docker_details"] = dockerDetailsAttribute()
+	serviceDetails["plan"] = schema.StringAttribute{
+		MarkdownDescription: "The plan for the service. Valid values are `starter`, `starter_plus`, `standard`, `standard_plus`, `pro`, `pro_plus`, `pro_max`, `pro_ultra`. Default: `starter`.",
+		Optional:            true,
+		Computed:            true,
+		Validators:          []validator.String{stringvalidator.OneOf(servicePlans...)},
+		PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+	}
+	serviceDetails["region"] = schema.StringAttribute{
+		MarkdownDescription: "The region for the service. Valid values are `oregon`, `ohio`, `virginia`, `frankfurt`, `singapore`. Defaults to `oregon`.",
+		Optional:            true,
+		Computed:            true,
+		Validators:          []validator.String{stringvalidator.OneOf(regions...)},
+		PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+	}
+
+	return schema.Schema{
+		MarkdownDescription: description + "\n~> **Note:** You can't create free-tier services with the Render API.",
+		Version:             1,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the service",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the service",
+				Required:            true,
+			},
+			"owner_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the owner of the service",
+				Required:            true,
+			},
+			"repo": schema.StringAttribute{
+				MarkdownDescription: "The git repository of the service",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"auto_deploy": schema.StringAttribute{
+				MarkdownDescription: "Whether the service is set to auto-deploy. Valid values are `yes` or `no`. Default: `yes`.",
+				Optional:            true,
+				Computed:            true,
+				Validators:          []validator.String{stringvalidator.OneOf(yesOrNo...)},
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"branch": schema.StringAttribute{
+				MarkdownDescription: "The branch of the service. If left empty, this will fall back to the default branch of the repository",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"image": schema.SingleNestedAttribute{
+				MarkdownDescription: "The image used for this server",
+				Optional:            true,
+				Default:             nil,
+				PlanModifiers:       []planmodifier.Object{objectplanmodifier.UseStateForUnknown()},
+				Attributes: map[string]schema.Attribute{
+					"owner_id": schema.StringAttribute{
+						MarkdownDescription: "The ID of the owner for this image. This should match the owner of the service as well as the owner of any specified registry credential.",
+						Required:            true,
+					},
+					"registry_credential_id": schema.StringAttribute{
+						MarkdownDescription: "Optional reference to the registry credential passed to the image repository to retrieve this image.",
+						Optional:            true,
+					},
+					"image_path": schema.StringAttribute{
+						MarkdownDescription: "Path to the image used for this server e.g `docker.io/library/nginx:latest`.",
+						Required:            true,
+					},
+				},
+			},
+			"build_filter": buildFilterAttribute(),
+			"root_dir": schema.StringAttribute{
+				MarkdownDescription: "The root directory of the service",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"service_details": schema.SingleNestedAttribute{
+				MarkdownDescription: "The service details for the service",
+				Required:            true,
+				Attributes:          serviceDetails,
+			},
+			"secret_files": schema.ListNestedAttribute{
+				MarkdownDescription: "The secret files for the service",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the secret file",
+							Optional:            true,
+							Computed:            true,
+							PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+						},
+						"content": schema.StringAttribute{
+							MarkdownDescription: "The content of the secret file",
+							Optional:            true,
+							Computed:            true,
+							PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+						},
+					},
+				},
+			},
+			"environment_variables": schema.MapNestedAttribute{
+				MarkdownDescription: "The environment variables for the service, keyed by name. When not set, the variables of the service are left alone, so that they can be managed with `render_service_env_var`.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: environmentVariableAttributes(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the service. Valid values are `web_service`, `static_site`, `cron_job`, `background_worker`, `private_service`.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The date and time the service was created",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "The date and time the service was last updated",
+				Computed:            true,
+			},
+			"wait_for_deploy": schema.BoolAttribute{
+				MarkdownDescription: "Whether to wait on create and update until a new deploy of the service is live. An update that doesn't start a deploy waits until the update timeout expires. Default: `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create:            true,
+				CreateDescription: "How long to wait for the deploy after creating the service when `wait_for_deploy` is set. Default: `30m`.",
+				Update:            true,
+				UpdateDescription: "How long to wait for the deploy after updating the service when `wait_for_deploy` is set. Default: `30m`.",
+			}),
+			"image_path": schema.StringAttribute{MarkdownDescription: "The image path for the service",
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"notify_on_fail": schema.StringAttribute{
+				MarkdownDescription: "Whether to notify on fail. Valid values are `default`, `notify` or `ignore`.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "The slug of the service",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"suspended": schema.StringAttribute{
+				MarkdownDescription: "Whether the service is suspended. Valid values are `suspended` or `not_suspended`.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"suspenders": schema.ListAttribute{
+				MarkdownDescription: "The suspenders of the service",
+				ElementType:         types.StringType,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
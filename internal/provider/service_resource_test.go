@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestWarnDiskRemoval(t *testing.T) {
+	withDisk := testWebServiceModel("node")
+	withoutDisk := testWebServiceModel("node")
+	withoutDisk.ServiceDetails.Disk = nil
+
+	tests := map[string]struct {
+		state, plan any
+		wantWarning bool
+	}{
+		"disk removed":      {state: withDisk, plan: withoutDisk, wantWarning: true},
+		"disk kept":         {state: withDisk, plan: withDisk},
+		"disk added":        {state: withoutDisk, plan: withDisk},
+		"service created":   {plan: withDisk},
+		"service destroyed": {state: withDisk},
+	}
+
+	ctx := context.Background()
+	r := NewWebService()
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	value := func(model any) tftypes.Value {
+		if model == nil {
+			return tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+		}
+		return testStateValue(t, r, model)
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: value(test.plan)}
+			req := resource.ModifyPlanRequest{
+				State:  tfsdk.State{Schema: schemaResp.Schema, Raw: value(test.state)},
+				Plan:   plan,
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: value(test.plan)},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			warnDiskRemoval(ctx, req, resp)
+
+			if got := resp.Diagnostics.WarningsCount() > 0; got != test.wantWarning {
+				t.Errorf("expected a warning: %t, got: %v", test.wantWarning, resp.Diagnostics)
+			}
+			if resp.Diagnostics.HasError() {
+				t.Errorf("unexpected error: %v", resp.Diagnostics)
+			}
+		})
+	}
+}
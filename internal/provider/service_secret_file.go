@@ -0,0 +1,228 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sonlir/render-client-go"
+
+	"terraform-provider-render/internal/api"
+)
+
+var (
+	_ resource.Resource                = &ServiceSecretFile{}
+	_ resource.ResourceWithConfigure   = &ServiceSecretFile{}
+	_ resource.ResourceWithImportState = &ServiceSecretFile{}
+)
+
+func NewServiceSecretFile() resource.Resource {
+	return &ServiceSecretFile{}
+}
+
+type ServiceSecretFile struct {
+	client *api.Client
+}
+
+type ServiceSecretFileModel struct {
+	ID        types.String `tfsdk:"id"`
+	ServiceID types.String `tfsdk:"service_id"`
+	Name      types.String `tfsdk:"name"`
+	Content   types.String `tfsdk:"content"`
+}
+
+func (r *ServiceSecretFile) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_secret_file"
+}
+
+func (r *ServiceSecretFile) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a single secret file of a service and leaves the other files alone, so that several configurations can add files to the same service. " +
+			"Don't configure `secret_files` on the service resource at the same time.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the secret file, in the form `<service_id>/<name>`",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the service",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the secret file",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "The content of the secret file",
+				Required:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *ServiceSecretFile) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*render.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = api.NewClient(client)
+}
+
+func (r *ServiceSecretFile) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ServiceSecretFileModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The endpoint creates or updates the file, so an existing file would
+	// silently be taken over.
+	_, err := r.client.GetServiceSecretFile(plan.ServiceID.ValueString(), plan.Name.ValueString())
+	if err == nil {
+		resp.Diagnostics.AddError(
+			"Error creating Render service secret file",
+			"Secret file "+plan.Name.ValueString()+" already exists on service ID: "+plan.ServiceID.ValueString()+". Import it to manage it with Terraform.",
+		)
+		return
+	}
+	if !api.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error creating Render service secret file",
+			"Could not get secret file "+plan.Name.ValueString()+" of service ID: "+plan.ServiceID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	secretFile, err := r.client.UpdateServiceSecretFile(plan.ServiceID.ValueString(), plan.Name.ValueString(), plan.Content.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Render service secret file",
+			"Could not create secret file "+plan.Name.ValueString()+" on service ID: "+plan.ServiceID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	makeServiceSecretFileModel(&plan, secretFile)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ServiceSecretFile) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ServiceSecretFileModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secretFile, err := r.client.GetServiceSecretFile(state.ServiceID.ValueString(), state.Name.ValueString())
+	if api.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not get Render service secret file: "+state.ID.ValueString(),
+			err.Error(),
+		)
+		return
+	}
+
+	makeServiceSecretFileModel(&state, secretFile)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ServiceSecretFile) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ServiceSecretFileModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secretFile, err := r.client.UpdateServiceSecretFile(plan.ServiceID.ValueString(), plan.Name.ValueString(), plan.Content.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Render service secret file",
+			"Could not update secret file "+plan.Name.ValueString()+" on service ID: "+plan.ServiceID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	makeServiceSecretFileModel(&plan, secretFile)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ServiceSecretFile) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ServiceSecretFileModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteServiceSecretFile(state.ServiceID.ValueString(), state.Name.ValueString())
+	if err != nil && !api.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting Render service secret file",
+			"Could not delete secret file "+state.Name.ValueString()+" from service ID: "+state.ServiceID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *ServiceSecretFile) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serviceID, name, ok := strings.Cut(req.ID, "/")
+	if !ok || serviceID == "" || name == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <service_id>/<name>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), serviceID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+func makeServiceSecretFileModel(state *ServiceSecretFileModel, secretFile *api.SecretFile) {
+	state.ID = types.StringValue(state.ServiceID.ValueString() + "/" + state.Name.ValueString())
+	state.Content = types.StringValue(secretFile.Content)
+}
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/sonlir/render-client-go"
+
+	"terraform-provider-render/internal/fakerender"
+)
+
+func TestServiceSecretFileResource(t *testing.T) {
+	server, providerConfig := newTestServer(t)
+
+	var serviceID string
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckServiceDestroy(server, "render_web_service"),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testServiceSecretFileConfig("s3cr3t"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("render_web_service.test", "id", func(value string) error {
+						serviceID = value
+						return nil
+					}),
+					resource.TestCheckResourceAttr("render_service_secret_file.test", "name", "credentials.json"),
+					resource.TestCheckResourceAttr("render_service_secret_file.test", "content", "s3cr3t"),
+					testCheckServiceSecretFile(server, "s3cr3t"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "render_service_secret_file.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testServiceSecretFileConfig("n3w"),
+				Check:  testCheckServiceSecretFile(server, "n3w"),
+			},
+			// Changes made outside of Terraform are detected and reverted
+			{
+				PreConfig: func() {
+					server.UpdateService(serviceID, func(service *render.Service) {
+						service.SecretFiles = []render.SecretFiles{}
+					})
+				},
+				Config:             providerConfig + testServiceSecretFileConfig("n3w"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: providerConfig + testServiceSecretFileConfig("n3w"),
+				Check:  testCheckServiceSecretFile(server, "n3w"),
+			},
+		},
+	})
+}
+
+func testServiceSecretFileConfig(content string) string {
+	return fmt.Sprintf(`
+resource "render_web_service" "test" {
+  name     = "my-app"
+  owner_id = %q
+  repo     = "https://github.com/render-examples/express-hello-world"
+
+  service_details = {
+    env           = "node"
+    num_instances = 1
+    native_environment_details = {
+      build_command = "yarn"
+      start_command = "node app.js"
+    }
+  }
+}
+
+resource "render_service_secret_file" "test" {
+  service_id = render_web_service.test.id
+  name       = "credentials.json"
+  content    = %q
+}
+`, testOwnerID, content)
+}
+
+func testCheckServiceSecretFile(server *fakerender.Server, content string) resource.TestCheckFunc {
+	return testCheckService(server, "render_web_service.test", func(service render.Service) error {
+		for _, secretFile := range service.SecretFiles {
+			if secretFile.Name == "credentials.json" && secretFile.Contents == content {
+				return nil
+			}
+		}
+		return fmt.Errorf("expected credentials.json with %q, got %+v", content, service.SecretFiles)
+	})
+}
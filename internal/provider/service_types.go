@@ -1,28 +1,42 @@
 package provider
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sonlir/render-client-go"
 )
 
 type ServiceDataSourceModel struct {
-	AutoDeploy     types.String          `tfsdk:"auto_deploy"`
-	Branch         types.String          `tfsdk:"branch"`
-	BuildFilter    *BuildFilter          `tfsdk:"build_filter"`
-	CreateAt       types.String          `tfsdk:"created_at"`
-	EnvVars        []EnvironmentVariable `tfsdk:"environment_variables"`
-	ID             types.String          `tfsdk:"id"`
-	ImagePath      types.String          `tfsdk:"image_path"`
-	Name           types.String          `tfsdk:"name"`
-	NotifyOnFail   types.String          `tfsdk:"notify_on_fail"`
-	OwnerID        types.String          `tfsdk:"owner_id"`
-	Repo           types.String          `tfsdk:"repo"`
-	RootDir        types.String          `tfsdk:"root_dir"`
-	ServiceDetails interface{}           `tfsdk:"service_details"`
-	Slug           types.String          `tfsdk:"slug"`
-	Suspended      types.String          `tfsdk:"suspended"`
-	Suspenders     []types.String        `tfsdk:"suspenders"`
-	Type           types.String          `tfsdk:"type"`
-	UpdatedAt      types.String          `tfsdk:"updated_at"`
+	AutoDeploy     types.String                                  `tfsdk:"auto_deploy"`
+	Branch         types.String                                  `tfsdk:"branch"`
+	BuildFilter    *BuildFilter                                  `tfsdk:"build_filter"`
+	CreateAt       types.String                                  `tfsdk:"created_at"`
+	EnvVars        map[string]EnvironmentVariableDataSourceModel `tfsdk:"environment_variables"`
+	ID             types.String                                  `tfsdk:"id"`
+	ImagePath      types.String                                  `tfsdk:"image_path"`
+	Name           types.String                                  `tfsdk:"name"`
+	NotifyOnFail   types.String                                  `tfsdk:"notify_on_fail"`
+	OwnerID        types.String                                  `tfsdk:"owner_id"`
+	Repo           types.String                                  `tfsdk:"repo"`
+	RootDir        types.String                                  `tfsdk:"root_dir"`
+	ServiceDetails interface{}                                   `tfsdk:"service_details"`
+	Slug           types.String                                  `tfsdk:"slug"`
+	Suspended      types.String                                  `tfsdk:"suspended"`
+	Suspenders     []types.String                                `tfsdk:"suspenders"`
+	Type           types.String                                  `tfsdk:"type"`
+	UpdatedAt      types.String                                  `tfsdk:"updated_at"`
 }
 
 type BuildFilter struct {
@@ -36,15 +50,9 @@ type Image struct {
 	ImagePath            types.String `tfsdk:"image_path"`
 }
 
-type Route struct {
-	Type        types.String `tfsdk:"type"`
-	Source      types.String `tfsdk:"source"`
-	Destination types.String `tfsdk:"destination"`
-}
-
 type SecretFiles struct {
 	Name     types.String `tfsdk:"name"`
-	Contents types.String `tfsdk:"contents"`
+	Contents types.String `tfsdk:"content"`
 }
 
 type Autoscaling struct {
@@ -88,12 +96,6 @@ type NativeEnvironmentDetails struct {
 	PreDeployCommand types.String `tfsdk:"pre_deploy_command"`
 }
 
-type Header struct {
-	Path  types.String `tfsdk:"path"`
-	Name  types.String `tfsdk:"name"`
-	Value types.String `tfsdk:"value"`
-}
-
 type Disk struct {
 	Name      types.String `tfsdk:"name"`
 	MountPath types.String `tfsdk:"mount_path"`
@@ -101,7 +103,378 @@ type Disk struct {
 	ID        types.String `tfsdk:"id"`
 }
 
+// EnvironmentVariable is the value of an environment variable. Environment
+// variables are kept in maps keyed by name, so that the order Render lists
+// them in doesn't matter.
+//
+// GenerateValue and IgnoreValue only exist in the configuration: Render
+// doesn't know how a value was set.
 type EnvironmentVariable struct {
-	Key   types.String `tfsdk:"key"`
+	Value         types.String `tfsdk:"value"`
+	GenerateValue types.Bool   `tfsdk:"generate_value"`
+	IgnoreValue   types.Bool   `tfsdk:"ignore_value"`
+}
+
+// EnvironmentVariableDataSourceModel is an environment variable as read by the
+// service data sources.
+type EnvironmentVariableDataSourceModel struct {
 	Value types.String `tfsdk:"value"`
 }
+
+type IPAllowListEntry struct {
+	CIDRBlock   types.String `tfsdk:"cidr_block"`
+	Description types.String `tfsdk:"description"`
+}
+
+var ipAllowListEntryType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"cidr_block":  types.StringType,
+	"description": types.StringType,
+}}
+
+// The values Render accepts, shared by the schema validators.
+var (
+	serviceEnvs  = []string{"node", "python", "ruby", "go", "elixir", "image", "rust", "docker"}
+	servicePlans = []string{"starter", "starter_plus", "standard", "standard_plus", "pro", "pro_plus", "pro_max", "pro_ultra"}
+	regions      = []string{"oregon", "ohio", "virginia", "frankfurt", "singapore"}
+	yesOrNo      = []string{"yes", "no"}
+)
+
+var environmentVariableType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"value":          types.StringType,
+	"generate_value": types.BoolType,
+	"ignore_value":   types.BoolType,
+}}
+
+// environmentVariableAttributes returns the attributes of the environment
+// variables managed by a resource. The value is sensitive, as values generated
+// by Render are secrets.
+func environmentVariableAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"value": schema.StringAttribute{
+			MarkdownDescription: "The value of the environment variable. Required unless `generate_value` or `ignore_value` is set.",
+			Optional:            true,
+			Computed:            true,
+			Sensitive:           true,
+			Validators:          []validator.String{envVarValueValidator{}},
+			PlanModifiers:       []planmodifier.String{envVarValueModifier{}},
+		},
+		"generate_value": schema.BoolAttribute{
+			MarkdownDescription: "Whether Render generates a random value for the environment variable when it is created. The value is kept afterwards, including when it is changed outside of Terraform. Conflicts with `value`. Default: `false`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"ignore_value": schema.BoolAttribute{
+			MarkdownDescription: "Whether Terraform only manages the name of the environment variable. The variable is created with `value`, or empty if it isn't set, and later changes to its value are ignored, whether they are made outside of Terraform or in the configuration. Default: `false`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+	}
+}
+
+// buildFilterAttribute returns the build filter of a service resource. The
+// paths default to empty lists rather than being computed, so that they are
+// known when only one of them is configured.
+func buildFilterAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "The build filter for this service",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"paths": schema.ListAttribute{
+				MarkdownDescription: "The paths that trigger a deploy when they change. Default: `[]`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+			},
+			"ignored_paths": schema.ListAttribute{
+				MarkdownDescription: "The paths that don't trigger a deploy when they change. Default: `[]`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+			},
+		},
+	}
+}
+
+// nativeEnvironmentDetailsAttribute returns the build and start commands of a
+// service resource using a native runtime.
+func nativeEnvironmentDetailsAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "The build and start commands for services using a native runtime",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"pre_deploy_command": schema.StringAttribute{
+				MarkdownDescription: "The pre-deploy command for the service",
+				Optional:            true,
+			},
+			"build_command": schema.StringAttribute{
+				MarkdownDescription: "The build command for the service",
+				Required:            true,
+			},
+			"start_command": schema.StringAttribute{
+				MarkdownDescription: "The start command for the service",
+				Required:            true,
+			},
+		},
+	}
+}
+
+// dockerDetailsAttribute returns the docker build details of a service
+// resource using the docker runtime. Render fills in the ones that are not
+// configured.
+func dockerDetailsAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "The docker build details for services using the `docker` runtime",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"docker_command": schema.StringAttribute{
+				MarkdownDescription: "The docker command for the service",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"docker_context": schema.StringAttribute{
+				MarkdownDescription: "The docker context for the service",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"dockerfile_path": schema.StringAttribute{
+				MarkdownDescription: "The dockerfile path for the service.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"pre_deploy_command": schema.StringAttribute{
+				MarkdownDescription: "The pre-deploy command for the service",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"registry_credential_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the registry credential for the service",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+// scalableServiceDetailsAttributes returns the service details of the service
+// resources that run instances: web services, private services and background
+// workers.
+func scalableServiceDetailsAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"autoscaling": schema.SingleNestedAttribute{
+			MarkdownDescription: "The autoscaling for the service",
+			Optional:            true,
+			Default:             nil,
+			Attributes: map[string]schema.Attribute{
+				"enabled": schema.BoolAttribute{
+					MarkdownDescription: "Whether autoscaling is enabled.",
+					Optional:            true,
+				},
+				"min": schema.Int64Attribute{
+					MarkdownDescription: "The minimum number of instances.",
+					Optional:            true,
+					Validators:          []validator.Int64{int64validator.AtLeast(1)},
+				},
+				"max": schema.Int64Attribute{
+					MarkdownDescription: "The maximum number of instances.",
+					Optional:            true,
+					Validators:          []validator.Int64{int64validator.AtLeast(1), int64validator.AtLeastSumOf(path.MatchRelative().AtParent().AtName("min"))},
+				},
+				"criteria": schema.SingleNestedAttribute{
+					MarkdownDescription: "The autoscaling criteria for the service",
+					Required:            true,
+					Attributes: map[string]schema.Attribute{
+						"cpu": schema.SingleNestedAttribute{
+							MarkdownDescription: "The CPU autoscaling criteria for the service",
+							Required:            true,
+							Attributes: map[string]schema.Attribute{
+								"enabled": schema.BoolAttribute{
+									MarkdownDescription: "Whether CPU autoscaling is enabled.",
+									Optional:            true,
+								},
+								"percentage": schema.Int64Attribute{
+									MarkdownDescription: "Determines when your service will be scaled. If the average resource utilization is significantly above/below the target, we will increase/decrease the number of instances.",
+									Optional:            true,
+									Validators:          []validator.Int64{int64validator.Between(1, 100)},
+								},
+							},
+						},
+						"memory": schema.SingleNestedAttribute{
+							MarkdownDescription: "The memory autoscaling criteria for the service",
+							Required:            true,
+							Attributes: map[string]schema.Attribute{
+								"enabled": schema.BoolAttribute{
+									MarkdownDescription: "Whether memory autoscaling is enabled.",
+									Optional:            true,
+								},
+								"percentage": schema.Int64Attribute{
+									MarkdownDescription: "Determines when your service will be scaled. If the average resource utilization is significantly above/below the target, we will increase/decrease the number of instances.",
+									Optional:            true,
+									Validators:          []validator.Int64{int64validator.Between(1, 100)},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"pull_request_previews_enabled": schema.StringAttribute{
+			MarkdownDescription: "Whether pull request previews are enabled. Valid values are `yes` or `no`. Default: `no`.",
+			Optional:            true,
+			Computed:            true,
+			Validators:          []validator.String{stringvalidator.OneOf(yesOrNo...)},
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+		"disk": schema.SingleNestedAttribute{
+			MarkdownDescription: "The disk for the service. **Removing this block deletes the disk and all the data on it**, the plan warns when it does.",
+			Optional:            true,
+			Default:             nil,
+			PlanModifiers:       []planmodifier.Object{objectplanmodifier.UseStateForUnknown()},
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					MarkdownDescription: "The name of the disk",
+					Optional:            true,
+					Computed:            true,
+					PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				},
+				"size_gb": schema.Int64Attribute{
+					MarkdownDescription: "The size of the disk in GB. The disk is resized in place, but it can only grow. Default: `1`.",
+					Optional:            true,
+					Computed:            true,
+					PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown(), diskSizeGrowOnlyModifier{}},
+				},
+				"mount_path": schema.StringAttribute{
+					MarkdownDescription: "The absolute path the disk is mounted at, e.g. `/var/data`",
+					Required:            true,
+				},
+				"id": schema.StringAttribute{
+					MarkdownDescription: "The ID of the disk",
+					Computed:            true,
+					PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				},
+			},
+		},
+		"num_instances": schema.Int64Attribute{
+			MarkdownDescription: "The number of instances for the service. Default: `1`.",
+			Required:            true,
+			Validators:          []validator.Int64{int64validator.AtLeast(1)},
+		},
+		"parent_server": parentServerAttribute(),
+	}
+}
+
+// openPortsAttribute returns the ports a service resource listens on.
+func openPortsAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: "The open ports for the service",
+		Computed:            true,
+		PlanModifiers:       []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"port": schema.Int64Attribute{
+					MarkdownDescription: "The number of the open port",
+					Computed:            true,
+				},
+				"protocol": schema.StringAttribute{
+					MarkdownDescription: "The protocol of the open port",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+var secretFileType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"name":    types.StringType,
+	"content": types.StringType,
+}}
+
+var routeType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"type":        types.StringType,
+	"source":      types.StringType,
+	"destination": types.StringType,
+}}
+
+var headerType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"path":  types.StringType,
+	"name":  types.StringType,
+	"value": types.StringType,
+}}
+
+// knownStringPointer is like ValueStringPointer, but also returns nil for
+// unknown values so that attributes Render computes are left out of requests.
+func knownStringPointer(s types.String) *string {
+	if s.IsUnknown() {
+		return nil
+	}
+	return s.ValueStringPointer()
+}
+
+var openPortType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"port":     types.Int64Type,
+	"protocol": types.StringType,
+}}
+
+// stringListValue returns a list attribute of strings. Computed lists are
+// kept as types.List, as they are unknown when a resource is planned.
+func stringListValue(values []string) types.List {
+	elements := []attr.Value{}
+	for _, value := range values {
+		elements = append(elements, types.StringValue(value))
+	}
+	return types.ListValueMust(types.StringType, elements)
+}
+
+func openPortsValue(openPorts []render.OpenPort) types.List {
+	elements := []attr.Value{}
+	for _, openPort := range openPorts {
+		elements = append(elements, types.ObjectValueMust(openPortType.AttrTypes, map[string]attr.Value{
+			"port":     types.Int64Value(openPort.Port),
+			"protocol": types.StringValue(openPort.Protocol),
+		}))
+	}
+	return types.ListValueMust(openPortType, elements)
+}
+
+var parentServerType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"id":   types.StringType,
+	"name": types.StringType,
+}}
+
+// parentServerAttribute returns the parent server of a service resource.
+// Render only sets it on preview instances, so it is computed.
+func parentServerAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "The parent server of the service, when it is a preview instance",
+		Computed:            true,
+		PlanModifiers:       []planmodifier.Object{objectplanmodifier.UseStateForUnknown()},
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the parent server",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the parent server",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func parentServerValue(parentServer *render.ParentServer) types.Object {
+	if parentServer == nil {
+		return types.ObjectNull(parentServerType.AttrTypes)
+	}
+	return types.ObjectValueMust(parentServerType.AttrTypes, map[string]attr.Value{
+		"id":   types.StringValue(parentServer.ID),
+		"name": types.StringValue(parentServer.Name),
+	})
+}
@@ -0,0 +1,436 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sonlir/render-client-go"
+
+	"terraform-provider-render/internal/api"
+)
+
+var (
+	_ resource.Resource                 = &StaticSite{}
+	_ resource.ResourceWithConfigure    = &StaticSite{}
+	_ resource.ResourceWithImportState  = &StaticSite{}
+	_ resource.ResourceWithUpgradeState = &StaticSite{}
+	_ resource.ResourceWithModifyPlan   = &StaticSite{}
+)
+
+func NewStaticSite() resource.Resource {
+	return &StaticSite{}
+}
+
+type StaticSite struct {
+	client *api.Client
+}
+
+type StaticSiteModel struct {
+	AutoDeploy     types.String                   `tfsdk:"auto_deploy"`
+	Branch         types.String                   `tfsdk:"branch"`
+	BuildFilter    *BuildFilter                   `tfsdk:"build_filter"`
+	EnvVars        map[string]EnvironmentVariable `tfsdk:"environment_variables"`
+	ID             types.String                   `tfsdk:"id"`
+	Name           types.String                   `tfsdk:"name"`
+	OwnerID        types.String                   `tfsdk:"owner_id"`
+	Repo           types.String                   `tfsdk:"repo"`
+	RootDir        types.String                   `tfsdk:"root_dir"`
+	ServiceDetails *StaticSiteDetails             `tfsdk:"service_details"`
+	Type           types.String                   `tfsdk:"type"`
+	CreateAt       types.String                   `tfsdk:"created_at"`
+	NotifyOnFail   types.String                   `tfsdk:"notify_on_fail"`
+	Slug           types.String                   `tfsdk:"slug"`
+	Suspended      types.String                   `tfsdk:"suspended"`
+	Suspenders     types.List                     `tfsdk:"suspenders"`
+	UpdatedAt      types.String                   `tfsdk:"updated_at"`
+}
+
+type StaticSiteDetails struct {
+	BuildCommand               types.String `tfsdk:"build_command"`
+	Headers                    types.List   `tfsdk:"headers"`
+	ParentServer               types.Object `tfsdk:"parent_server"`
+	PublishPath                types.String `tfsdk:"publish_path"`
+	PullRequestPreviewsEnabled types.String `tfsdk:"pull_request_previews_enabled"`
+	Routes                     types.List   `tfsdk:"routes"`
+	URL                        types.String `tfsdk:"url"`
+}
+
+func (r *StaticSite) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_static_site"
+}
+
+func (r *StaticSite) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Creates a new Render static site owned by you or a team you belong to.",
+		Version:             1,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the service",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the service",
+				Required:            true,
+			},
+			"owner_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the owner of the service",
+				Required:            true,
+			},
+			"repo": schema.StringAttribute{
+				MarkdownDescription: "The git repository of the service",
+				Required:            true,
+			},
+			"auto_deploy": schema.StringAttribute{
+				MarkdownDescription: "Whether the service is set to auto-deploy. Valid values are `yes` or `no`. Default: `yes`.",
+				Optional:            true,
+				Computed:            true,
+				Validators:          []validator.String{stringvalidator.OneOf(yesOrNo...)},
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"branch": schema.StringAttribute{
+				MarkdownDescription: "The branch of the service. If left empty, this will fall back to the default branch of the repository",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"build_filter": buildFilterAttribute(),
+			"root_dir": schema.StringAttribute{
+				MarkdownDescription: "The root directory of the service",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"service_details": schema.SingleNestedAttribute{
+				MarkdownDescription: "The service details for the service",
+				Required:            true,
+				Attributes: map[string]schema.Attribute{
+					"build_command": schema.StringAttribute{
+						MarkdownDescription: "The command that builds the site, e.g. `npm run build`",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+					},
+					"publish_path": schema.StringAttribute{
+						MarkdownDescription: "The directory, relative to the root directory, that contains the built site. Default: `public`.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+					},
+					"pull_request_previews_enabled": schema.StringAttribute{
+						MarkdownDescription: "Whether pull request previews are enabled. Valid values are `yes` or `no`. Default: `no`.",
+						Optional:            true,
+						Computed:            true,
+						Validators:          []validator.String{stringvalidator.OneOf(yesOrNo...)},
+						PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+					},
+					"routes": schema.ListNestedAttribute{
+						MarkdownDescription: "The redirect and rewrite rules for the site. Rules are applied in order.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers:       []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"type": schema.StringAttribute{
+									MarkdownDescription: "The type of the rule. Valid values are `redirect` or `rewrite`.",
+									Required:            true,
+									Validators:          []validator.String{stringvalidator.OneOf("redirect", "rewrite")},
+								},
+								"source": schema.StringAttribute{
+									MarkdownDescription: "The path the rule matches, e.g. `/blog/*`",
+									Required:            true,
+								},
+								"destination": schema.StringAttribute{
+									MarkdownDescription: "The path or URL requests are redirected or rewritten to",
+									Required:            true,
+								},
+							},
+						},
+					},
+					"headers": schema.ListNestedAttribute{
+						MarkdownDescription: "The custom HTTP response headers for the site",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers:       []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"path": schema.StringAttribute{
+									MarkdownDescription: "The path the header is added to, e.g. `/*`",
+									Required:            true,
+								},
+								"name": schema.StringAttribute{
+									MarkdownDescription: "The name of the header",
+									Required:            true,
+								},
+								"value": schema.StringAttribute{
+									MarkdownDescription: "The value of the header",
+									Required:            true,
+								},
+							},
+						},
+					},
+					"parent_server": parentServerAttribute(),
+					"url": schema.StringAttribute{
+						MarkdownDescription: "The URL for the service",
+						Computed:            true,
+						PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+					},
+				},
+			},
+			"environment_variables": schema.MapNestedAttribute{
+				MarkdownDescription: "The environment variables available during the build, keyed by name. When not set, the variables of the service are left alone, so that they can be managed with `render_service_env_var`.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: environmentVariableAttributes(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the service. Valid values are `web_service`, `static_site`, `cron_job`, `background_worker`, `private_service`.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The date and time the service was created",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "The date and time the service was last updated",
+				Computed:            true,
+			},
+			"notify_on_fail": schema.StringAttribute{
+				MarkdownDescription: "Whether to notify on fail. Valid values are `default`, `notify` or `ignore`.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "The slug of the service",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"suspended": schema.StringAttribute{
+				MarkdownDescription: "Whether the service is suspended. Valid values are `suspended` or `not_suspended`.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"suspenders": schema.ListAttribute{
+				MarkdownDescription: "The suspenders of the service",
+				ElementType:         types.StringType,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+func (r *StaticSite) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planUnchangedEnvVars(req, resp)
+}
+
+func (r *StaticSite) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*render.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = api.NewClient(client)
+}
+
+func (r *StaticSite) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan StaticSiteModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	staticSite, err := r.client.CreateStaticSite(*makeStaticSiteData(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Render static site",
+			"Could not create static site, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	makeStaticSiteModel(&plan, staticSite)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *StaticSite) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state StaticSiteModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	staticSite, err := r.client.GetStaticSite(state.ID.ValueString())
+	if api.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not get Render static site: "+state.ID.ValueString(),
+			err.Error(),
+		)
+		return
+	}
+
+	makeStaticSiteModel(&state, staticSite)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *StaticSite) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state StaticSiteModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+
+	data := makeStaticSiteData(&plan)
+	if !envVarsChanged(plan.EnvVars, state.EnvVars) {
+		data.EnvVars = nil
+	}
+
+	staticSite, err := r.client.UpdateStaticSite(plan.ID.ValueString(), *data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Render static site",
+			"Could not update static site ID: "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	makeStaticSiteModel(&plan, staticSite)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *StaticSite) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state StaticSiteModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteService(state.ID.ValueString())
+	if err != nil && !api.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting Render static site",
+			"Could not delete static site ID: "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *StaticSite) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *StaticSite) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return envVarsStateUpgraders()
+}
+
+func makeStaticSiteModel(state *StaticSiteModel, staticSite *api.StaticSite) {
+	var staticSiteDetails StaticSiteDetails
+	// Nothing but the ID is known about a static site that is being imported.
+	imported := state.ServiceDetails == nil
+	state.AutoDeploy = types.StringValue(staticSite.AutoDeploy)
+	state.Branch = types.StringValue(staticSite.Branch)
+	state.BuildFilter = makeBuildFilterModel(state.BuildFilter, imported, staticSite.BuildFilter)
+	state.CreateAt = types.StringValue(staticSite.CreateAt)
+	state.ID = types.StringValue(staticSite.ID)
+	state.Name = types.StringValue(staticSite.Name)
+	state.NotifyOnFail = types.StringValue(staticSite.NotifyOnFail)
+	state.OwnerID = types.StringValue(staticSite.OwnerID)
+	state.Repo = types.StringValue(staticSite.Repo)
+	state.RootDir = types.StringValue(staticSite.RootDir)
+	state.Slug = types.StringValue(staticSite.Slug)
+	state.Suspended = types.StringValue(staticSite.Suspended)
+	state.Suspenders = stringListValue(staticSite.Suspenders)
+
+	state.Type = types.StringValue(staticSite.Type)
+	state.UpdatedAt = types.StringValue(staticSite.UpdatedAt)
+
+	staticSiteDetails.BuildCommand = types.StringValue(staticSite.ServiceDetails.BuildCommand)
+	staticSiteDetails.PublishPath = types.StringValue(staticSite.ServiceDetails.PublishPath)
+	staticSiteDetails.PullRequestPreviewsEnabled = types.StringValue(staticSite.ServiceDetails.PullRequestPreviewsEnabled)
+	staticSiteDetails.URL = types.StringValue(staticSite.ServiceDetails.URL)
+	staticSiteDetails.ParentServer = parentServerValue(staticSite.ServiceDetails.ParentServer)
+	staticSiteDetails.Routes = routesValue(staticSite.ServiceDetails.Routes)
+	staticSiteDetails.Headers = headersValue(staticSite.ServiceDetails.Headers)
+
+	state.EnvVars = makeServiceEnvVarsModel(state.EnvVars, imported, staticSite.EnvVars)
+
+	state.ServiceDetails = &staticSiteDetails
+}
+
+func makeStaticSiteData(plan *StaticSiteModel) *api.StaticSiteData {
+	staticSite := api.StaticSiteData{}
+	staticSiteDetails := plan.ServiceDetails
+
+	staticSiteDetailsData := api.StaticSiteDetails{
+		BuildCommand:               staticSiteDetails.BuildCommand.ValueString(),
+		PublishPath:                staticSiteDetails.PublishPath.ValueString(),
+		PullRequestPreviewsEnabled: staticSiteDetails.PullRequestPreviewsEnabled.ValueString(),
+		Routes:                     makeRoutesData(staticSiteDetails.Routes),
+		Headers:                    makeHeadersData(staticSiteDetails.Headers),
+	}
+
+	staticSite.Name = plan.Name.ValueString()
+	staticSite.OwnerID = plan.OwnerID.ValueString()
+	staticSite.Repo = plan.Repo.ValueString()
+	staticSite.AutoDeploy = plan.AutoDeploy.ValueString()
+	staticSite.Branch = plan.Branch.ValueString()
+	staticSite.RootDir = plan.RootDir.ValueString()
+	staticSite.ServiceDetails = staticSiteDetailsData
+	staticSite.EnvVars = makeEnvVarsData(plan.EnvVars)
+	staticSite.BuildFilter = makeBuildFilterData(plan.BuildFilter)
+	staticSite.Type = "static_site"
+
+	return &staticSite
+}
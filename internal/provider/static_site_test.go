@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestStaticSiteResource(t *testing.T) {
+	server, providerConfig := newTestServer(t)
+
+	var id string
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckServiceDestroy(server, "render_static_site"),
+		Steps: []resource.TestStep{
+			// Create and Read testing, leaving the headers and routes to Render
+			{
+				Config: providerConfig + testStaticSiteConfig("my-site", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("render_static_site.test", "id", func(value string) error {
+						id = value
+						return nil
+					}),
+					resource.TestCheckResourceAttr("render_static_site.test", "name", "my-site"),
+					resource.TestCheckResourceAttr("render_static_site.test", "type", "static_site"),
+					resource.TestCheckResourceAttr("render_static_site.test", "service_details.publish_path", "public"),
+					resource.TestCheckResourceAttr("render_static_site.test", "service_details.headers.#", "0"),
+					resource.TestCheckResourceAttr("render_static_site.test", "service_details.routes.#", "0"),
+					resource.TestCheckNoResourceAttr("render_static_site.test", "service_details.parent_server"),
+					resource.TestCheckResourceAttr("render_static_site.test", "build_filter.paths.0", "src/**"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "render_static_site.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testStaticSiteConfig("my-site-renamed", `
+    headers = [
+      { path = "/*", name = "X-Frame-Options", value = "DENY" },
+    ]
+    routes = [
+      { type = "rewrite", source = "/*", destination = "/index.html" },
+    ]
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("render_static_site.test", "id", &id),
+					resource.TestCheckResourceAttr("render_static_site.test", "name", "my-site-renamed"),
+					resource.TestCheckResourceAttr("render_static_site.test", "service_details.headers.#", "1"),
+					resource.TestCheckResourceAttr("render_static_site.test", "service_details.headers.0.value", "DENY"),
+					resource.TestCheckResourceAttr("render_static_site.test", "service_details.routes.#", "1"),
+					resource.TestCheckResourceAttr("render_static_site.test", "service_details.routes.0.destination", "/index.html"),
+				),
+			},
+		},
+	})
+}
+
+// testStaticSiteConfig returns a static site with extra added to its service
+// details.
+func testStaticSiteConfig(name, extra string) string {
+	return fmt.Sprintf(`
+resource "render_static_site" "test" {
+  name     = %q
+  owner_id = %q
+  repo     = "https://github.com/render-examples/create-react-app"
+
+  build_filter = {
+    paths = ["src/**"]
+  }
+
+  service_details = {
+    build_command = "npm run build"
+%s  }
+}
+`, name, testOwnerID, extra)
+}
@@ -89,15 +89,11 @@ func (d *WebServiceDataSource) Schema(ctx context.Context, req datasource.Schema
 				MarkdownDescription: "The root directory of the service",
 				Computed:            true,
 			},
-			"environment_variables": schema.ListNestedAttribute{
-				MarkdownDescription: "The environment variables for the service",
+			"environment_variables": schema.MapNestedAttribute{
+				MarkdownDescription: "The environment variables for the service, keyed by name",
 				Computed:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
-						"key": schema.StringAttribute{
-							MarkdownDescription: "The key of the environment variable",
-							Computed:            true,
-						},
 						"value": schema.StringAttribute{
 							MarkdownDescription: "The value of the environment variable",
 							Computed:            true,
@@ -248,7 +244,7 @@ func (d *WebServiceDataSource) Schema(ctx context.Context, req datasource.Schema
 						Computed:            true,
 					},
 					"region": schema.StringAttribute{
-						MarkdownDescription: "The region for the service. Valid values are `oregon` `frankfurt` . Defaults to `oregon`.",
+						MarkdownDescription: "The region for the service. Valid values are `oregon`, `ohio`, `virginia`, `frankfurt`, `singapore`. Defaults to `oregon`.",
 						Computed:            true,
 					},
 					"url": schema.StringAttribute{
@@ -369,99 +365,21 @@ func (d *WebServiceDataSource) Read(ctx context.Context, req datasource.ReadRequ
 }
 
 func makeWebServiceDataSourceModel(state *ServiceDataSourceModel, service *render.Service) {
-	var webServiceDetails WebServiceDetailsDataSource
-	state.AutoDeploy = types.StringValue(service.AutoDeploy)
-	state.Branch = types.StringValue(service.Branch)
-	if service.BuildFilter != nil {
-		for _, path := range service.BuildFilter.Paths {
-			state.BuildFilter.Paths = append(state.BuildFilter.Paths, types.StringValue(path))
-		}
-		for _, ignoredPath := range service.BuildFilter.IgnoredPaths {
-			state.BuildFilter.IgnoredPaths = append(state.BuildFilter.IgnoredPaths, types.StringValue(ignoredPath))
-		}
-	}
-	state.CreateAt = types.StringValue(service.CreateAt)
-	state.ImagePath = types.StringValue(service.ImagePath)
-	state.Name = types.StringValue(service.Name)
-	state.NotifyOnFail = types.StringValue(service.NotifyOnFail)
-	state.OwnerID = types.StringValue(service.OwnerID)
-	state.Repo = types.StringValue(service.Repo)
-	state.RootDir = types.StringValue(service.RootDir)
-	state.Slug = types.StringValue(service.Slug)
-	state.Suspended = types.StringValue(service.Suspended)
-	for _, suspender := range service.Suspenders {
-		state.Suspenders = append(state.Suspenders, types.StringValue(suspender))
-	}
-	state.Type = types.StringValue(service.Type)
-	state.UpdatedAt = types.StringValue(service.UpdatedAt)
-
-	webServiceDetails.NumInstances = types.Int64Value(service.ServiceDetails.NumInstances)
-	webServiceDetails.Env = types.StringValue(service.ServiceDetails.Env)
-	webServiceDetails.HealthCheckPath = types.StringValue(service.ServiceDetails.HealthCheckPath)
-	webServiceDetails.Plan = types.StringValue(service.ServiceDetails.Plan)
-	webServiceDetails.PullRequestPreviewsEnabled = types.StringValue(service.ServiceDetails.PullRequestPreviewsEnabled)
-	webServiceDetails.Region = types.StringValue(service.ServiceDetails.Region)
-	webServiceDetails.URL = types.StringValue(service.ServiceDetails.URL)
-	for _, openPort := range service.ServiceDetails.OpenPorts {
-		webServiceDetails.OpenPorts = append(webServiceDetails.OpenPorts, OpenPort{
-			Port:     types.Int64Value(openPort.Port),
-			Protocol: types.StringValue(openPort.Protocol),
-		})
-	}
-	if service.ServiceDetails.ParentServer != nil {
-		webServiceDetails.ParentServer = &ParentServer{
-			ID:   types.StringValue(service.ServiceDetails.ParentServer.ID),
-			Name: types.StringValue(service.ServiceDetails.ParentServer.Name),
-		}
-	}
-	if service.ServiceDetails.EnvSpecificDetails != nil {
-		webServiceDetails.DockerDetails = &DockerDetails{
-			DockerCommand:    types.StringPointerValue(service.ServiceDetails.EnvSpecificDetails.DockerCommand),
-			DockerContext:    types.StringPointerValue(service.ServiceDetails.EnvSpecificDetails.DockerContext),
-			DockerfilePath:   types.StringPointerValue(service.ServiceDetails.EnvSpecificDetails.DockerfilePath),
-			PreDeployCommand: types.StringPointerValue(service.ServiceDetails.EnvSpecificDetails.PreDeployCommand),
-		}
-		if service.ServiceDetails.EnvSpecificDetails.RegistryCredential != nil {
-			webServiceDetails.DockerDetails.RegistryCredentialId = types.StringValue(service.ServiceDetails.EnvSpecificDetails.RegistryCredential.ID)
-		}
-		webServiceDetails.NativeEnvironmentDetails = &NativeEnvironmentDetails{
-			PreDeployCommand: types.StringPointerValue(service.ServiceDetails.EnvSpecificDetails.PreDeployCommand),
-			BuildCommand:     types.StringPointerValue(service.ServiceDetails.EnvSpecificDetails.BuildCommand),
-			StartCommand:     types.StringPointerValue(service.ServiceDetails.EnvSpecificDetails.StartCommand),
-		}
+	makeServiceDataSourceModel(state, service)
+	dockerDetails, nativeEnvironmentDetails := makeEnvSpecificDetailsModel(service.ServiceDetails)
+	state.ServiceDetails = WebServiceDetailsDataSource{
+		Autoscaling:                makeAutoscalingModel(service.ServiceDetails.Autoscaling),
+		Disk:                       makeServiceDiskModel(nil, true, service.ServiceDetails.Disk),
+		Env:                        types.StringValue(service.ServiceDetails.Env),
+		DockerDetails:              dockerDetails,
+		NativeEnvironmentDetails:   nativeEnvironmentDetails,
+		HealthCheckPath:            types.StringValue(service.ServiceDetails.HealthCheckPath),
+		NumInstances:               types.Int64Value(service.ServiceDetails.NumInstances),
+		OpenPorts:                  makeOpenPortsModel(service.ServiceDetails.OpenPorts),
+		ParentServer:               makeParentServerModel(service.ServiceDetails.ParentServer),
+		Plan:                       types.StringValue(service.ServiceDetails.Plan),
+		PullRequestPreviewsEnabled: types.StringValue(service.ServiceDetails.PullRequestPreviewsEnabled),
+		Region:                     types.StringValue(service.ServiceDetails.Region),
+		URL:                        types.StringValue(service.ServiceDetails.URL),
 	}
-	if service.ServiceDetails.Disk != nil {
-		webServiceDetails.Disk = &Disk{
-			ID:        types.StringValue(service.ServiceDetails.Disk.Id),
-			Name:      types.StringValue(service.ServiceDetails.Disk.Name),
-			MountPath: types.StringValue(service.ServiceDetails.Disk.MountPath),
-			SizeGB:    types.Int64Value(service.ServiceDetails.Disk.SizeGB),
-		}
-	}
-	if service.ServiceDetails.Autoscaling != nil {
-		webServiceDetails.Autoscaling = &Autoscaling{
-			Enabled: types.BoolValue(service.ServiceDetails.Autoscaling.Enabled),
-			Min:     types.Int64Value(service.ServiceDetails.Autoscaling.Min),
-			Max:     types.Int64Value(service.ServiceDetails.Autoscaling.Max),
-			Criteria: AutoscalingCriteria{
-				CPU: AutoscalingCriteriaObject{
-					Enabled:    types.BoolValue(service.ServiceDetails.Autoscaling.Criteria.CPU.Enabled),
-					Percentage: types.Int64Value(service.ServiceDetails.Autoscaling.Criteria.CPU.Percentage),
-				},
-				Memory: AutoscalingCriteriaObject{
-					Enabled:    types.BoolValue(service.ServiceDetails.Autoscaling.Criteria.Memory.Enabled),
-					Percentage: types.Int64Value(service.ServiceDetails.Autoscaling.Criteria.Memory.Percentage),
-				},
-			},
-		}
-	}
-	state.EnvVars = []EnvironmentVariable{}
-	for i := len(service.EnvVars) - 1; i >= 0; i-- {
-		state.EnvVars = append(state.EnvVars, EnvironmentVariable{
-			Key:   types.StringValue(service.EnvVars[i].Key),
-			Value: types.StringValue(service.EnvVars[i].Value),
-		})
-	}
-
-	state.ServiceDetails = webServiceDetails
 }
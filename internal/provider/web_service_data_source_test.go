@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestWebServiceDataSource(t *testing.T) {
+	_, providerConfig := newTestServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testWebServiceConfig("my-app", "starter", 1) + `
+data "render_web_service" "test" {
+  id = render_web_service.test.id
+}
+
+data "render_web_services" "test" {
+  name = render_web_service.test.name
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.render_web_service.test", "id", "render_web_service.test", "id"),
+					resource.TestCheckResourceAttr("data.render_web_service.test", "name", "my-app"),
+					resource.TestCheckResourceAttr("data.render_web_service.test", "type", "web_service"),
+					resource.TestCheckResourceAttr("data.render_web_service.test", "service_details.plan", "starter"),
+					resource.TestCheckResourceAttr("data.render_web_service.test", "environment_variables.%", "2"),
+					resource.TestCheckResourceAttr("data.render_web_services.test", "web_services.#", "1"),
+					resource.TestCheckResourceAttrPair("data.render_web_services.test", "web_services.0.id", "render_web_service.test", "id"),
+				),
+			},
+		},
+	})
+}
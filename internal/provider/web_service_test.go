@@ -0,0 +1,304 @@
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/sonlir/render-client-go"
+
+	"terraform-provider-render/internal/fakerender"
+)
+
+func TestWebServiceResource(t *testing.T) {
+	server, providerConfig := newTestServer(t)
+
+	var id string
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckServiceDestroy(server, "render_web_service"),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testWebServiceConfig("my-app", "starter", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("render_web_service.test", "id", func(value string) error {
+						id = value
+						return nil
+					}),
+					resource.TestCheckResourceAttr("render_web_service.test", "name", "my-app"),
+					resource.TestCheckResourceAttr("render_web_service.test", "slug", "my-app"),
+					resource.TestCheckResourceAttr("render_web_service.test", "branch", "main"),
+					resource.TestCheckResourceAttr("render_web_service.test", "auto_deploy", "yes"),
+					resource.TestCheckResourceAttr("render_web_service.test", "service_details.plan", "starter"),
+					resource.TestCheckResourceAttr("render_web_service.test", "service_details.region", "oregon"),
+					resource.TestCheckResourceAttr("render_web_service.test", "service_details.url", "https://my-app.onrender.com"),
+					resource.TestCheckResourceAttr("render_web_service.test", "build_filter.paths.0", "src/**"),
+					resource.TestCheckResourceAttr("render_web_service.test", "build_filter.ignored_paths.#", "0"),
+					resource.TestCheckResourceAttr("render_web_service.test", "environment_variables.%", "2"),
+					resource.TestCheckResourceAttr("render_web_service.test", "environment_variables.NODE_ENV.value", "production"),
+					resource.TestCheckResourceAttr("render_web_service.test", "secret_files.0.content", "s3cr3t"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "render_web_service.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "render_web_service.test",
+				ImportState:       true,
+				ImportStateId:     testOwnerID + "/my-app",
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "render_web_service.test",
+				ImportState:       true,
+				ImportStateId:     "my-app",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testWebServiceConfig("my-app-renamed", "standard", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("render_web_service.test", "id", &id),
+					resource.TestCheckResourceAttr("render_web_service.test", "name", "my-app-renamed"),
+					resource.TestCheckResourceAttr("render_web_service.test", "service_details.plan", "standard"),
+					resource.TestCheckResourceAttr("render_web_service.test", "service_details.num_instances", "2"),
+					testCheckService(server, "render_web_service.test", func(service render.Service) error {
+						if service.ServiceDetails.Plan != "standard" || service.ServiceDetails.NumInstances != 2 {
+							return fmt.Errorf("web service was not updated: %+v", service.ServiceDetails)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestWebServiceResourceDrift(t *testing.T) {
+	server, providerConfig := newTestServer(t)
+	config := providerConfig + testWebServiceConfig("my-app", "starter", 1)
+
+	var id string
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckServiceDestroy(server, "render_web_service"),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.TestCheckResourceAttrWith("render_web_service.test", "id", func(value string) error {
+					id = value
+					return nil
+				}),
+			},
+			// Changes made outside of Terraform are detected and reverted
+			{
+				PreConfig: func() {
+					server.UpdateService(id, func(service *render.Service) {
+						service.ServiceDetails.Plan = "pro"
+						service.EnvVars = append(service.EnvVars, render.EnvironmentVariable{Key: "DEBUG", Value: "1"})
+					})
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("render_web_service.test", "service_details.plan", "starter"),
+					resource.TestCheckResourceAttr("render_web_service.test", "environment_variables.%", "2"),
+					testCheckService(server, "render_web_service.test", func(service render.Service) error {
+						if service.ServiceDetails.Plan != "starter" || len(service.EnvVars) != 2 {
+							return fmt.Errorf("web service was not reverted: %+v", service)
+						}
+						return nil
+					}),
+				),
+			},
+			// The order Render lists environment variables in doesn't matter
+			{
+				PreConfig: func() {
+					server.UpdateService(id, func(service *render.Service) {
+						slices.Reverse(service.EnvVars)
+					})
+				},
+				Config:   config,
+				PlanOnly: true,
+			},
+			// A web service deleted outside of Terraform is created again
+			{
+				PreConfig: func() {
+					server.DeleteService(id)
+				},
+				Config: config,
+				Check: resource.TestCheckResourceAttrWith("render_web_service.test", "id", func(value string) error {
+					if value == id {
+						return fmt.Errorf("expected a new web service, got %s", value)
+					}
+					return nil
+				}),
+			},
+		},
+	})
+}
+
+func TestWebServiceResourceEnvVarValues(t *testing.T) {
+	server, providerConfig := newTestServer(t)
+
+	var id, secret string
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckServiceDestroy(server, "render_web_service"),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testWebServiceEnvVarValuesConfig("production", "initial"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("render_web_service.test", "id", func(value string) error {
+						id = value
+						return nil
+					}),
+					resource.TestCheckResourceAttrWith("render_web_service.test", "environment_variables.SESSION_SECRET.value", func(value string) error {
+						if value == "" {
+							return fmt.Errorf("expected a generated value")
+						}
+						secret = value
+						return nil
+					}),
+					resource.TestCheckResourceAttr("render_web_service.test", "environment_variables.API_TOKEN.value", "initial"),
+					resource.TestCheckResourceAttr("render_web_service.test", "environment_variables.DASHBOARD_ONLY.value", ""),
+				),
+			},
+			// Values changed outside of Terraform are kept
+			{
+				PreConfig: func() {
+					server.UpdateService(id, func(service *render.Service) {
+						for i := range service.EnvVars {
+							if service.EnvVars[i].Key != "NODE_ENV" {
+								service.EnvVars[i].Value = "rotated"
+							}
+						}
+					})
+				},
+				Config:   providerConfig + testWebServiceEnvVarValuesConfig("production", "initial"),
+				PlanOnly: true,
+			},
+			// Ignored values changed in the configuration are kept as well,
+			// and updating the other variables doesn't regenerate the secret
+			{
+				Config: providerConfig + testWebServiceEnvVarValuesConfig("staging", "changed"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("render_web_service.test", "environment_variables.NODE_ENV.value", "staging"),
+					resource.TestCheckResourceAttr("render_web_service.test", "environment_variables.API_TOKEN.value", "rotated"),
+					testCheckService(server, "render_web_service.test", func(service render.Service) error {
+						for _, envVar := range service.EnvVars {
+							if envVar.Key == "NODE_ENV" && envVar.Value != "staging" {
+								return fmt.Errorf("expected NODE_ENV to be updated, got %q", envVar.Value)
+							}
+							if envVar.Key != "NODE_ENV" && envVar.Value != "rotated" {
+								return fmt.Errorf("expected %s to keep its value, got %q (generated %q)", envVar.Key, envVar.Value, secret)
+							}
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testWebServiceConfig(name, plan string, numInstances int) string {
+	return fmt.Sprintf(`
+resource "render_web_service" "test" {
+  name     = %q
+  owner_id = %q
+  repo     = "https://github.com/render-examples/express-hello-world"
+
+  build_filter = {
+    paths = ["src/**"]
+  }
+
+  service_details = {
+    env           = "node"
+    plan          = %q
+    num_instances = %d
+    native_environment_details = {
+      build_command = "yarn"
+      start_command = "node app.js"
+    }
+  }
+
+  environment_variables = {
+    NODE_ENV = { value = "production" }
+    PORT     = { value = "10000" }
+  }
+
+  secret_files = [
+    { name = "secret.txt", content = "s3cr3t" },
+  ]
+}
+`, name, testOwnerID, plan, numInstances)
+}
+
+// testCheckService runs check on the service of the resource name in the fake
+// Render API.
+func testCheckService(server *fakerender.Server, name string, check func(render.Service) error) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", name)
+		}
+		service, ok := server.Service(rs.Primary.ID)
+		if !ok {
+			return fmt.Errorf("service %s does not exist", rs.Primary.ID)
+		}
+		return check(service)
+	}
+}
+
+// testCheckServiceDestroy checks that the services of every resource of
+// resourceType were deleted.
+func testCheckServiceDestroy(server *fakerender.Server, resourceType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+			if _, ok := server.Service(rs.Primary.ID); ok {
+				return fmt.Errorf("service %s still exists", rs.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
+
+func testWebServiceEnvVarValuesConfig(nodeEnv, apiToken string) string {
+	return fmt.Sprintf(`
+resource "render_web_service" "test" {
+  name     = "my-app"
+  owner_id = %q
+  repo     = "https://github.com/render-examples/express-hello-world"
+
+  service_details = {
+    env           = "node"
+    num_instances = 1
+    native_environment_details = {
+      build_command = "yarn"
+      start_command = "node app.js"
+    }
+  }
+
+  environment_variables = {
+    NODE_ENV       = { value = %q }
+    SESSION_SECRET = { generate_value = true }
+    API_TOKEN      = { value = %q, ignore_value = true }
+    DASHBOARD_ONLY = { ignore_value = true }
+  }
+}
+`, testOwnerID, nodeEnv, apiToken)
+}
@@ -82,15 +82,11 @@ func (d *WebServicesDataSource) Schema(ctx context.Context, req datasource.Schem
 							MarkdownDescription: "The root directory of the service",
 							Computed:            true,
 						},
-						"environment_variables": schema.ListNestedAttribute{
-							MarkdownDescription: "The environment variables for the service",
+						"environment_variables": schema.MapNestedAttribute{
+							MarkdownDescription: "The environment variables for the service, keyed by name",
 							Computed:            true,
 							NestedObject: schema.NestedAttributeObject{
 								Attributes: map[string]schema.Attribute{
-									"key": schema.StringAttribute{
-										MarkdownDescription: "The key of the environment variable",
-										Computed:            true,
-									},
 									"value": schema.StringAttribute{
 										MarkdownDescription: "The value of the environment variable",
 										Computed:            true,
@@ -242,7 +238,7 @@ func (d *WebServicesDataSource) Schema(ctx context.Context, req datasource.Schem
 									Computed:            true,
 								},
 								"region": schema.StringAttribute{
-									MarkdownDescription: "The region for the service. Valid values are `oregon` `frankfurt` . Defaults to `oregon`.",
+									MarkdownDescription: "The region for the service. Valid values are `oregon`, `ohio`, `virginia`, `frankfurt`, `singapore`. Defaults to `oregon`.",
 									Computed:            true,
 								},
 								"url": schema.StringAttribute{